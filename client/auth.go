@@ -2,6 +2,7 @@ package client
 
 import (
 	"context"
+	"fmt"
 
 	cosmossdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
@@ -14,9 +15,14 @@ const (
 	methodQueryAccounts = "/cosmos.auth.v1beta1.Query/Accounts" // Endpoint for listing accounts with pagination
 )
 
-// Account retrieves an account by its address using a gRPC query.
+// Account retrieves an account by its address using a gRPC query, or by reading its store key with a proof if a
+// light client is set.
 // Returns the account interface and any potential error encountered.
 func (c *Client) Account(ctx context.Context, accAddr cosmossdk.AccAddress) (res auth.AccountI, err error) {
+	if c.queryLightClient != nil {
+		return c.verifiedAccount(ctx, accAddr)
+	}
+
 	var (
 		resp auth.QueryAccountResponse
 		req  = &auth.QueryAccountRequest{Address: accAddr.String()}
@@ -35,6 +41,25 @@ func (c *Client) Account(ctx context.Context, accAddr cosmossdk.AccAddress) (res
 	return res, nil
 }
 
+// verifiedAccount retrieves an account by reading its store key in the auth store with a proof.
+// Returns the account interface, nil if the account does not exist, and any error encountered.
+func (c *Client) verifiedAccount(ctx context.Context, accAddr cosmossdk.AccAddress) (res auth.AccountI, err error) {
+	value, err := c.queryVerifiedStoreKey(ctx, auth.StoreKey, auth.AddressStoreKey(accAddr))
+	if err != nil {
+		return nil, err
+	}
+	if value == nil {
+		return nil, nil
+	}
+
+	// Accounts are stored as interfaces by the auth module.
+	if err := c.protoCodec.UnmarshalInterface(value, &res); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal account: %w", err)
+	}
+
+	return res, nil
+}
+
 // Accounts retrieves a list of accounts with pagination support using a gRPC query.
 // Returns a slice of account interfaces, pagination details, and any potential error.
 func (c *Client) Accounts(ctx context.Context, pageReq *query.PageRequest) (res []auth.AccountI, pageRes *query.PageResponse, err error) {
@@ -58,3 +83,11 @@ func (c *Client) Accounts(ctx context.Context, pageReq *query.PageRequest) (res
 
 	return res, resp.Pagination, nil
 }
+
+// AllAccounts returns an iterator over all accounts.
+// Pages are fetched on demand according to the given options.
+func (c *Client) AllAccounts(ctx context.Context, opts PageOptions) func(yield func(auth.AccountI, error) bool) {
+	return iteratePages(ctx, opts, func(ctx context.Context, pageReq *query.PageRequest) ([]auth.AccountI, *query.PageResponse, error) {
+		return c.Accounts(ctx, pageReq)
+	})
+}
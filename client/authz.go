@@ -0,0 +1,161 @@
+package client
+
+import (
+	"context"
+	"time"
+
+	core "github.com/cometbft/cometbft/rpc/core/types"
+	cosmossdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+const (
+	// gRPC methods for querying authorization grants
+	methodQueryGrants        = "/cosmos.authz.v1beta1.Query/Grants"        // Endpoint for listing grants between a granter and a grantee
+	methodQueryGranterGrants = "/cosmos.authz.v1beta1.Query/GranterGrants" // Endpoint for listing grants issued by a granter
+	methodQueryGranteeGrants = "/cosmos.authz.v1beta1.Query/GranteeGrants" // Endpoint for listing grants received by a grantee
+)
+
+// Grants retrieves the grants issued by the granter to the grantee with pagination support using a gRPC query.
+// An empty msgTypeURL matches the grants of all message types.
+// Returns the grants, pagination details, and any potential error.
+func (c *Client) Grants(ctx context.Context, granterAddr, granteeAddr cosmossdk.AccAddress, msgTypeURL string, pageReq *query.PageRequest) ([]*authz.Grant, *query.PageResponse, error) {
+	var (
+		resp authz.QueryGrantsResponse
+		req  = &authz.QueryGrantsRequest{
+			Granter:    granterAddr.String(),
+			Grantee:    granteeAddr.String(),
+			MsgTypeUrl: msgTypeURL,
+			Pagination: pageReq,
+		}
+	)
+
+	// Perform the gRPC query to fetch the paginated grants.
+	if err := c.QueryGRPC(ctx, methodQueryGrants, req, &resp); err != nil {
+		return nil, nil, IsNotFoundError(err)
+	}
+
+	return resp.Grants, resp.Pagination, nil
+}
+
+// AllGrants returns an iterator over all grants issued by the granter to the grantee.
+// Pages are fetched on demand according to the given options.
+func (c *Client) AllGrants(ctx context.Context, granterAddr, granteeAddr cosmossdk.AccAddress, msgTypeURL string, opts PageOptions) func(yield func(*authz.Grant, error) bool) {
+	return iteratePages(ctx, opts, func(ctx context.Context, pageReq *query.PageRequest) ([]*authz.Grant, *query.PageResponse, error) {
+		return c.Grants(ctx, granterAddr, granteeAddr, msgTypeURL, pageReq)
+	})
+}
+
+// GranterGrants retrieves the grants issued by the granter with pagination support using a gRPC query.
+// Returns the grants, pagination details, and any potential error.
+func (c *Client) GranterGrants(ctx context.Context, granterAddr cosmossdk.AccAddress, pageReq *query.PageRequest) ([]*authz.GrantAuthorization, *query.PageResponse, error) {
+	var (
+		resp authz.QueryGranterGrantsResponse
+		req  = &authz.QueryGranterGrantsRequest{
+			Granter:    granterAddr.String(),
+			Pagination: pageReq,
+		}
+	)
+
+	// Perform the gRPC query to fetch the paginated grants.
+	if err := c.QueryGRPC(ctx, methodQueryGranterGrants, req, &resp); err != nil {
+		return nil, nil, err
+	}
+
+	return resp.Grants, resp.Pagination, nil
+}
+
+// AllGranterGrants returns an iterator over all grants issued by the granter.
+// Pages are fetched on demand according to the given options.
+func (c *Client) AllGranterGrants(ctx context.Context, granterAddr cosmossdk.AccAddress, opts PageOptions) func(yield func(*authz.GrantAuthorization, error) bool) {
+	return iteratePages(ctx, opts, func(ctx context.Context, pageReq *query.PageRequest) ([]*authz.GrantAuthorization, *query.PageResponse, error) {
+		return c.GranterGrants(ctx, granterAddr, pageReq)
+	})
+}
+
+// GranteeGrants retrieves the grants received by the grantee with pagination support using a gRPC query.
+// Returns the grants, pagination details, and any potential error.
+func (c *Client) GranteeGrants(ctx context.Context, granteeAddr cosmossdk.AccAddress, pageReq *query.PageRequest) ([]*authz.GrantAuthorization, *query.PageResponse, error) {
+	var (
+		resp authz.QueryGranteeGrantsResponse
+		req  = &authz.QueryGranteeGrantsRequest{
+			Grantee:    granteeAddr.String(),
+			Pagination: pageReq,
+		}
+	)
+
+	// Perform the gRPC query to fetch the paginated grants.
+	if err := c.QueryGRPC(ctx, methodQueryGranteeGrants, req, &resp); err != nil {
+		return nil, nil, err
+	}
+
+	return resp.Grants, resp.Pagination, nil
+}
+
+// AllGranteeGrants returns an iterator over all grants received by the grantee.
+// Pages are fetched on demand according to the given options.
+func (c *Client) AllGranteeGrants(ctx context.Context, granteeAddr cosmossdk.AccAddress, opts PageOptions) func(yield func(*authz.GrantAuthorization, error) bool) {
+	return iteratePages(ctx, opts, func(ctx context.Context, pageReq *query.PageRequest) ([]*authz.GrantAuthorization, *query.PageResponse, error) {
+		return c.GranteeGrants(ctx, granteeAddr, pageReq)
+	})
+}
+
+// Grant authorizes the grantee to execute messages on behalf of the signing account.
+// A nil expiration creates a grant that does not expire.
+// Returns the broadcast result and any error encountered.
+func (c *Client) Grant(ctx context.Context, granteeAddr cosmossdk.AccAddress, authorization authz.Authorization, expiration *time.Time) (*core.ResultBroadcastTx, error) {
+	granterAddr, err := c.FromAddr()
+	if err != nil {
+		return nil, err
+	}
+
+	// Build the message and broadcast it.
+	msg, err := authz.NewMsgGrant(granterAddr, granteeAddr, authorization, expiration)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.BroadcastMsgs(ctx, msg)
+}
+
+// GrantGeneric authorizes the grantee to execute messages of the given type URL on behalf of the signing account
+// without any restrictions.
+// Returns the broadcast result and any error encountered.
+func (c *Client) GrantGeneric(ctx context.Context, granteeAddr cosmossdk.AccAddress, msgTypeURL string, expiration *time.Time) (*core.ResultBroadcastTx, error) {
+	return c.Grant(ctx, granteeAddr, authz.NewGenericAuthorization(msgTypeURL), expiration)
+}
+
+// Revoke revokes the authorization of the grantee to execute messages of the given type URL
+// on behalf of the signing account.
+// Returns the broadcast result and any error encountered.
+func (c *Client) Revoke(ctx context.Context, granteeAddr cosmossdk.AccAddress, msgTypeURL string) (*core.ResultBroadcastTx, error) {
+	granterAddr, err := c.FromAddr()
+	if err != nil {
+		return nil, err
+	}
+
+	// Build the message and broadcast it.
+	msg := authz.NewMsgRevoke(granterAddr, granteeAddr, msgTypeURL)
+	return c.BroadcastMsgs(ctx, &msg)
+}
+
+// ExecAs sets the granter on whose behalf messages are executed and returns the updated Client.
+// Once set, messages built by the Client use the granter as their sender, and BroadcastTx wraps
+// the outgoing messages in a MsgExec signed by the key used for signing transactions.
+// A nil granter disables the wrapping.
+func (c *Client) ExecAs(granterAddr cosmossdk.AccAddress) *Client {
+	c.txExecGranterAddr = granterAddr
+	return c
+}
+
+// wrapMsgsForExec wraps the given messages in a MsgExec from the grantee if a granter is configured.
+// Returns the messages unchanged otherwise.
+func (c *Client) wrapMsgsForExec(granteeAddr cosmossdk.AccAddress, msgs []cosmossdk.Msg) []cosmossdk.Msg {
+	if c.txExecGranterAddr.Empty() {
+		return msgs
+	}
+
+	msg := authz.NewMsgExec(granteeAddr, msgs)
+	return []cosmossdk.Msg{&msg}
+}
@@ -0,0 +1,141 @@
+package client
+
+import (
+	"context"
+
+	core "github.com/cometbft/cometbft/rpc/core/types"
+	cosmossdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	bank "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+const (
+	// gRPC methods for querying bank information
+	methodQueryBalance           = "/cosmos.bank.v1beta1.Query/Balance"           // Endpoint for retrieving the balance of a single denom
+	methodQueryAllBalances       = "/cosmos.bank.v1beta1.Query/AllBalances"       // Endpoint for listing all balances with pagination
+	methodQuerySpendableBalances = "/cosmos.bank.v1beta1.Query/SpendableBalances" // Endpoint for listing spendable balances with pagination
+	methodQueryDenomMetadata     = "/cosmos.bank.v1beta1.Query/DenomMetadata"     // Endpoint for retrieving the metadata of a denom
+)
+
+// Balance retrieves the balance of an account for the given denom using a gRPC query.
+// Returns the balance coin and any potential error encountered.
+func (c *Client) Balance(ctx context.Context, accAddr cosmossdk.AccAddress, denom string) (*cosmossdk.Coin, error) {
+	var (
+		resp bank.QueryBalanceResponse
+		req  = &bank.QueryBalanceRequest{
+			Address: accAddr.String(),
+			Denom:   denom,
+		}
+	)
+
+	// Perform the gRPC query to fetch the balance.
+	if err := c.QueryGRPC(ctx, methodQueryBalance, req, &resp); err != nil {
+		return nil, err
+	}
+
+	return resp.Balance, nil
+}
+
+// Balances retrieves the balances of an account with pagination support using a gRPC query.
+// Returns the balance coins, pagination details, and any potential error.
+func (c *Client) Balances(ctx context.Context, accAddr cosmossdk.AccAddress, pageReq *query.PageRequest) (cosmossdk.Coins, *query.PageResponse, error) {
+	var (
+		resp bank.QueryAllBalancesResponse
+		req  = &bank.QueryAllBalancesRequest{
+			Address:    accAddr.String(),
+			Pagination: pageReq,
+		}
+	)
+
+	// Perform the gRPC query to fetch the paginated balances.
+	if err := c.QueryGRPC(ctx, methodQueryAllBalances, req, &resp); err != nil {
+		return nil, nil, err
+	}
+
+	return resp.Balances, resp.Pagination, nil
+}
+
+// AllBalances returns an iterator over all balances of an account.
+// Pages are fetched on demand according to the given options.
+func (c *Client) AllBalances(ctx context.Context, accAddr cosmossdk.AccAddress, opts PageOptions) func(yield func(cosmossdk.Coin, error) bool) {
+	return iteratePages(ctx, opts, func(ctx context.Context, pageReq *query.PageRequest) ([]cosmossdk.Coin, *query.PageResponse, error) {
+		return c.Balances(ctx, accAddr, pageReq)
+	})
+}
+
+// SpendableBalances retrieves the spendable balances of an account with pagination support using a gRPC query.
+// Locked vesting coins are excluded from the result.
+// Returns the spendable coins, pagination details, and any potential error.
+func (c *Client) SpendableBalances(ctx context.Context, accAddr cosmossdk.AccAddress, pageReq *query.PageRequest) (cosmossdk.Coins, *query.PageResponse, error) {
+	var (
+		resp bank.QuerySpendableBalancesResponse
+		req  = &bank.QuerySpendableBalancesRequest{
+			Address:    accAddr.String(),
+			Pagination: pageReq,
+		}
+	)
+
+	// Perform the gRPC query to fetch the paginated spendable balances.
+	if err := c.QueryGRPC(ctx, methodQuerySpendableBalances, req, &resp); err != nil {
+		return nil, nil, err
+	}
+
+	return resp.Balances, resp.Pagination, nil
+}
+
+// AllSpendableBalances returns an iterator over all spendable balances of an account.
+// Pages are fetched on demand according to the given options.
+func (c *Client) AllSpendableBalances(ctx context.Context, accAddr cosmossdk.AccAddress, opts PageOptions) func(yield func(cosmossdk.Coin, error) bool) {
+	return iteratePages(ctx, opts, func(ctx context.Context, pageReq *query.PageRequest) ([]cosmossdk.Coin, *query.PageResponse, error) {
+		return c.SpendableBalances(ctx, accAddr, pageReq)
+	})
+}
+
+// DenomMetadata retrieves the metadata of the given denom using a gRPC query.
+// Returns the denom metadata and any potential error encountered.
+func (c *Client) DenomMetadata(ctx context.Context, denom string) (*bank.Metadata, error) {
+	var (
+		resp bank.QueryDenomMetadataResponse
+		req  = &bank.QueryDenomMetadataRequest{Denom: denom}
+	)
+
+	// Perform the gRPC query to fetch the denom metadata.
+	if err := c.QueryGRPC(ctx, methodQueryDenomMetadata, req, &resp); err != nil {
+		return nil, IsNotFoundError(err)
+	}
+
+	return &resp.Metadata, nil
+}
+
+// Send transfers the given coins from the signing account to the given address.
+// Returns the broadcast result and any error encountered.
+func (c *Client) Send(ctx context.Context, toAddr cosmossdk.AccAddress, amount cosmossdk.Coins) (*core.ResultBroadcastTx, error) {
+	fromAddr, err := c.FromAddr()
+	if err != nil {
+		return nil, err
+	}
+
+	// Build the message and broadcast it.
+	msg := bank.NewMsgSend(fromAddr, toAddr, amount)
+	return c.BroadcastMsgs(ctx, msg)
+}
+
+// MultiSend transfers coins from the signing account to multiple addresses within a single message.
+// The input of the message is the sum of all outputs.
+// Returns the broadcast result and any error encountered.
+func (c *Client) MultiSend(ctx context.Context, outputs []bank.Output) (*core.ResultBroadcastTx, error) {
+	fromAddr, err := c.FromAddr()
+	if err != nil {
+		return nil, err
+	}
+
+	// Sum up the outputs to determine the total amount sent by the signing account.
+	total := cosmossdk.NewCoins()
+	for _, output := range outputs {
+		total = total.Add(output.Coins...)
+	}
+
+	// Build the message and broadcast it.
+	msg := bank.NewMsgMultiSend([]bank.Input{bank.NewInput(fromAddr, total)}, outputs)
+	return c.BroadcastMsgs(ctx, msg)
+}
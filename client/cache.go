@@ -0,0 +1,240 @@
+package client
+
+import (
+	"container/list"
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	core "github.com/cometbft/cometbft/rpc/core/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+// uncachedMethods are the gRPC methods whose responses are never cached, as transactions are signed and paid for
+// based on them and must see the current state of the chain.
+var uncachedMethods = map[string]bool{
+	methodQueryAccount:    true,
+	methodQueryAllowance:  true,
+	methodQueryAllowances: true,
+	methodQueryConfig:     true,
+	methodSimulate:        true,
+}
+
+// queryCacheEntry holds a cached query response along with its expiry time.
+type queryCacheEntry struct {
+	key       string    // Cache key built from the gRPC method and request bytes
+	value     []byte    // Encoded query response
+	expiresAt time.Time // Time after which the response is stale
+}
+
+// QueryCache is a size bounded cache of gRPC query responses, keyed by method and request bytes.
+// Only the methods opted in through WithMethods or WithMethodTTL are cached, each for its own TTL. The account,
+// fee allowance, node config and simulation queries used to sign and pay for transactions are never cached.
+// Once full, the least recently used entries are evicted.
+type QueryCache struct {
+	defaultTTL time.Duration            // TTL of the methods opted in through WithMethods
+	maxEntries int                      // Maximum number of cached responses
+	ttls       map[string]time.Duration // TTL of the responses, keyed by gRPC method
+
+	mu      sync.Mutex
+	entries map[string]*list.Element // Cached entries, keyed by cache key
+	order   *list.List               // Cached entries, from most to least recently used
+}
+
+// NewQueryCache creates a cache holding up to maxEntries responses. Methods opted in through WithMethods are cached
+// for defaultTTL.
+func NewQueryCache(maxEntries int, defaultTTL time.Duration) *QueryCache {
+	return &QueryCache{
+		defaultTTL: defaultTTL,
+		maxEntries: maxEntries,
+		ttls:       make(map[string]time.Duration),
+		entries:    make(map[string]*list.Element),
+		order:      list.New(),
+	}
+}
+
+// WithMethods opts the given gRPC methods in to caching for the default TTL and returns the updated QueryCache.
+func (qc *QueryCache) WithMethods(methods ...string) *QueryCache {
+	qc.mu.Lock()
+	defer qc.mu.Unlock()
+
+	for _, method := range methods {
+		qc.ttls[method] = qc.defaultTTL
+	}
+
+	return qc
+}
+
+// WithMethodTTL opts the given gRPC method in to caching with its own TTL of the responses of the given gRPC method and returns the updated QueryCache.
+// A zero TTL disables caching for the method.
+func (qc *QueryCache) WithMethodTTL(method string, ttl time.Duration) *QueryCache {
+	qc.mu.Lock()
+	defer qc.mu.Unlock()
+
+	qc.ttls[method] = ttl
+	return qc
+}
+
+// queryCacheKey builds the cache key of a query from its gRPC method and request bytes.
+func queryCacheKey(method string, data []byte) string {
+	return method + "\x00" + string(data)
+}
+
+// ttl returns the TTL of the responses of the given gRPC method, zero if the method is not opted in to caching or
+// is never cached.
+func (qc *QueryCache) ttl(method string) time.Duration {
+	if uncachedMethods[method] {
+		return 0
+	}
+
+	return qc.ttls[method]
+}
+
+// Get returns the cached response of the query with the given gRPC method and request bytes, if still fresh.
+func (qc *QueryCache) Get(method string, data []byte) ([]byte, bool) {
+	qc.mu.Lock()
+	defer qc.mu.Unlock()
+
+	elem, ok := qc.entries[queryCacheKey(method, data)]
+	if !ok {
+		return nil, false
+	}
+
+	// Drop the entry if it has expired.
+	entry := elem.Value.(*queryCacheEntry)
+	if time.Now().After(entry.expiresAt) {
+		qc.remove(elem)
+		return nil, false
+	}
+
+	qc.order.MoveToFront(elem)
+	return entry.value, true
+}
+
+// Set caches the response of the query with the given gRPC method and request bytes for the TTL of the method,
+// evicting the least recently used entries if the cache is full.
+func (qc *QueryCache) Set(method string, data, value []byte) {
+	qc.mu.Lock()
+	defer qc.mu.Unlock()
+
+	ttl := qc.ttl(method)
+	if ttl <= 0 || qc.maxEntries <= 0 {
+		return
+	}
+
+	key := queryCacheKey(method, data)
+	entry := &queryCacheEntry{
+		key:       key,
+		value:     value,
+		expiresAt: time.Now().Add(ttl),
+	}
+
+	// Replace the existing entry, if any.
+	if elem, ok := qc.entries[key]; ok {
+		elem.Value = entry
+		qc.order.MoveToFront(elem)
+		return
+	}
+
+	qc.entries[key] = qc.order.PushFront(entry)
+	for qc.order.Len() > qc.maxEntries {
+		qc.remove(qc.order.Back())
+	}
+}
+
+// remove deletes the given element from the cache. The caller must hold the lock.
+func (qc *QueryCache) remove(elem *list.Element) {
+	entry := qc.order.Remove(elem).(*queryCacheEntry)
+	delete(qc.entries, entry.key)
+}
+
+// Invalidate removes the cached response of the query with the given gRPC method and request bytes.
+func (qc *QueryCache) Invalidate(method string, data []byte) {
+	qc.mu.Lock()
+	defer qc.mu.Unlock()
+
+	if elem, ok := qc.entries[queryCacheKey(method, data)]; ok {
+		qc.remove(elem)
+	}
+}
+
+// Purge removes all cached responses.
+func (qc *QueryCache) Purge() {
+	qc.mu.Lock()
+	defer qc.mu.Unlock()
+
+	qc.entries = make(map[string]*list.Element)
+	qc.order.Init()
+}
+
+// Len returns the number of cached responses, including expired ones not yet evicted.
+func (qc *QueryCache) Len() int {
+	qc.mu.Lock()
+	defer qc.mu.Unlock()
+
+	return qc.order.Len()
+}
+
+// cachingQuerier is a querier middleware serving fresh responses from a cache and caching the others.
+type cachingQuerier struct {
+	cache *QueryCache
+	next  Querier
+}
+
+// Query returns the cached response of the query if still fresh, or performs it and caches the response.
+// Queries pinned to an explicit height and the methods which are never cached bypass the cache.
+func (q *cachingQuerier) Query(ctx context.Context, method string, height int64, data []byte) ([]byte, error) {
+	if height != 0 || uncachedMethods[method] {
+		return q.next.Query(ctx, method, height, data)
+	}
+
+	if buf, ok := q.cache.Get(method, data); ok {
+		return buf, nil
+	}
+
+	buf, err := q.next.Query(ctx, method, height, data)
+	if err != nil {
+		return nil, err
+	}
+
+	q.cache.Set(method, data, buf)
+	return buf, nil
+}
+
+// WithQueryCache sets the cache of the gRPC query responses and returns the updated Client.
+// Queries are not cached if no cache is set, or if the query height is set.
+func (c *Client) WithQueryCache(cache *QueryCache) *Client {
+	c.queryCache = cache
+	return c
+}
+
+// InvalidateQuery removes the cached response of the query with the given gRPC method and request, if any.
+// Returns an error if the request cannot be marshaled.
+func (c *Client) InvalidateQuery(method string, req codec.ProtoMarshaler) error {
+	if c.queryCache == nil {
+		return nil
+	}
+
+	data, err := c.protoCodec.Marshal(req)
+	if err != nil {
+		return fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	c.queryCache.Invalidate(method, data)
+	return nil
+}
+
+// InvalidateQueryCacheOnNewBlock purges the query cache whenever a new block is committed, until the context is done.
+// The new block subscription is served by the healthiest RPC endpoint and re-established whenever the connection
+// is lost; blocks committed meanwhile only expire entries through their TTL.
+func (c *Client) InvalidateQueryCacheOnNewBlock(ctx context.Context) {
+	go c.subscribe(ctx, []string{cmttypes.EventQueryNewBlockHeader.String()}, func(core.ResultEvent) bool {
+		if c.queryCache != nil {
+			c.queryCache.Purge()
+		}
+
+		return true
+	})
+}
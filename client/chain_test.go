@@ -0,0 +1,59 @@
+package client_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	cosmossdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sentinel-official/sentinel-go-sdk/client"
+	"github.com/sentinel-official/sentinel-go-sdk/client/clienttest"
+)
+
+// Chain ID of the stand-in chains used in tests
+const testChainID = "sentinelhub-test"
+
+// newTestChain starts a stand-in chain which is closed once the test is done.
+func newTestChain(t *testing.T) *clienttest.Chain {
+	t.Helper()
+
+	ch := clienttest.NewChain(testChainID)
+	t.Cleanup(ch.Close)
+
+	return ch
+}
+
+// newTestClient creates a client of the given chain which signs with a new key of the given name, and creates the
+// account of the key on the chain.
+// Returns the client and the address of the account.
+func newTestClient(t *testing.T, ch *clienttest.Chain, name string) (*client.Client, cosmossdk.AccAddress) {
+	t.Helper()
+
+	kr := keyring.NewInMemory(ch.ProtoCodec())
+	record, _, err := kr.NewMnemonic(name, keyring.English, cosmossdk.FullFundraiserPath, "", hd.Secp256k1)
+	if err != nil {
+		t.Fatalf("failed to create key: %v", err)
+	}
+
+	accAddr, err := record.GetAddress()
+	if err != nil {
+		t.Fatalf("failed to get key address: %v", err)
+	}
+
+	ch.AddAccount(accAddr)
+
+	c := client.New().
+		WithChainID(ch.ChainID()).
+		WithKeyring(kr).
+		WithProtoCodec(ch.ProtoCodec()).
+		WithQueryRetries(1).
+		WithRPCAddr(ch.URL()).
+		WithRPCTimeout(5 * time.Second).
+		WithTxConfig(ch.TxConfig()).
+		WithTxFromName(name).
+		WithTxGas(200_000)
+
+	return c, accAddr
+}
@@ -1,8 +1,10 @@
 package client
 
 import (
+	"sync"
 	"time"
 
+	"github.com/cometbft/cometbft/light"
 	"github.com/cometbft/cometbft/rpc/client/http"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -21,13 +23,22 @@ type Client struct {
 	chainID              string                    // The chain ID used to identify the blockchain network
 	keyring              keyring.Keyring           // Keyring for managing private keys and signatures
 	protoCodec           codec.ProtoCodecMarshaler // Used for marshaling and unmarshaling protobuf data
+	querier              Querier                   // Transport used for gRPC queries, ABCI if not set
+	queryCache           *QueryCache               // Cache of gRPC query responses, no caching if not set
+	queryAllowUnverified bool                      // Flag for performing queries which cannot be verified without verification
 	queryHeight          int64                     // Query height for blockchain data
+	queryLightClient     *light.Client             // Light client used to verify query results against trusted headers
 	queryProve           bool                      // Flag indicating whether to prove queries
 	queryRetries         uint                      // Number of retries for queries
 	queryRetryDelay      time.Duration             // Delay between query retries
-	rpcAddr              string                    // RPC server address
+	retryPolicy          *RetryPolicy              // Policy for retrying queries and broadcasts
+	rpcEndpoints         []*endpoint               // RPC server endpoints with their health information
+	rpcRefreshInterval   time.Duration             // Interval after which the endpoints are refreshed in the background
 	rpcTimeout           time.Duration             // RPC timeout duration
+	txAutoFeeGranter     bool                      // Flag for selecting the fee granter from the available allowances
 	txConfig             client.TxConfig           // Configuration related to transactions (e.g., signing modes)
+	txExecGranterAddr    types.AccAddress          // Address on whose behalf messages are executed through authz
+	txFeeEstimator       *FeeEstimator             // Estimator of transaction fees, static fees if not set
 	txFeeGranterAddr     types.AccAddress          // Address that grants transaction fees
 	txFees               types.Coins               // Fees for transactions
 	txFromName           string                    // Sender name for transactions
@@ -36,12 +47,21 @@ type Client struct {
 	txGas                uint64                    // Gas limit for transactions
 	txMemo               string                    // Memo attached to transactions
 	txSimulateAndExecute bool                      // Flag for simulating and executing transactions
+	txSimulateQuerier    Querier                   // Transport used for simulating transactions, querier if not set
 	txTimeoutHeight      uint64                    // Transaction timeout height
+
+	sequences   map[string]*accountSequence // Cached account sequences keyed by account address
+	sequencesMu sync.Mutex                  // Mutex protecting the sequences map
+
+	rpcRefreshedAt time.Time  // Time at which the last background refresh of the endpoints started
+	rpcRefreshMu   sync.Mutex // Mutex protecting the time of the last background refresh
 }
 
 // New initializes a new Client instance.
 func New() *Client {
-	return &Client{}
+	return &Client{
+		rpcRefreshInterval: endpointRefreshInterval,
+	}
 }
 
 // WithChainID sets the blockchain chain ID and returns the updated Client.
@@ -62,6 +82,13 @@ func (c *Client) WithProtoCodec(protoCodec codec.ProtoCodecMarshaler) *Client {
 	return c
 }
 
+// WithQueryHeight sets the height at which queries are performed, or the latest height if zero,
+// and returns the updated Client.
+func (c *Client) WithQueryHeight(height int64) *Client {
+	c.queryHeight = height
+	return c
+}
+
 // WithQueryProve sets the prove flag for queries and returns the updated Client.
 func (c *Client) WithQueryProve(prove bool) *Client {
 	c.queryProve = prove
@@ -69,12 +96,14 @@ func (c *Client) WithQueryProve(prove bool) *Client {
 }
 
 // WithQueryRetries sets the number of retries for queries and returns the updated Client.
+// It is ignored if a retry policy is set.
 func (c *Client) WithQueryRetries(retries uint) *Client {
 	c.queryRetries = retries
 	return c
 }
 
 // WithQueryRetryDelay sets the retry delay duration for queries and returns the updated Client.
+// It is ignored if a retry policy is set.
 func (c *Client) WithQueryRetryDelay(delay time.Duration) *Client {
 	c.queryRetryDelay = delay
 	return c
@@ -82,7 +111,24 @@ func (c *Client) WithQueryRetryDelay(delay time.Duration) *Client {
 
 // WithRPCAddr sets the RPC server address and returns the updated Client.
 func (c *Client) WithRPCAddr(rpcAddr string) *Client {
-	c.rpcAddr = rpcAddr
+	return c.WithRPCAddrs(rpcAddr)
+}
+
+// WithRPCAddrs sets the RPC server addresses to fail over between and returns the updated Client.
+func (c *Client) WithRPCAddrs(rpcAddrs ...string) *Client {
+	c.rpcEndpoints = make([]*endpoint, len(rpcAddrs))
+	for i, addr := range rpcAddrs {
+		c.rpcEndpoints[i] = newEndpoint(addr)
+	}
+
+	return c
+}
+
+// WithRPCRefreshInterval sets the interval after which the health of all RPC endpoints is refreshed in the
+// background, or disables the background refresh if zero, and returns the updated Client.
+// Refreshing lets the client notice an endpoint lagging behind the others while it keeps serving requests.
+func (c *Client) WithRPCRefreshInterval(interval time.Duration) *Client {
+	c.rpcRefreshInterval = interval
 	return c
 }
 
@@ -152,9 +198,9 @@ func (c *Client) WithTxTimeoutHeight(height uint64) *Client {
 	return c
 }
 
-// HTTP creates an HTTP client for the given RPC address and timeout configuration.
+// HTTP returns the HTTP client of the healthiest RPC endpoint, creating it with the configured timeout on first use.
 // Returns the HTTP client or an error if initialization fails.
 func (c *Client) HTTP() (*http.HTTP, error) {
-	timeout := uint(c.rpcTimeout / time.Second)
-	return http.NewWithTimeout(c.rpcAddr, "/websocket", timeout)
+	_, http, err := c.rpc()
+	return http, err
 }
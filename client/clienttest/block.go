@@ -0,0 +1,177 @@
+package clienttest
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cometbft/cometbft/light"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	cmtversion "github.com/cometbft/cometbft/proto/tendermint/version"
+	core "github.com/cometbft/cometbft/rpc/core/types"
+	rpctypes "github.com/cometbft/cometbft/rpc/jsonrpc/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/cometbft/cometbft/version"
+	auth "github.com/cosmos/cosmos-sdk/x/auth/types"
+	v1base "github.com/sentinel-official/hub/v12/types/v1"
+	nodekeys "github.com/sentinel-official/hub/v12/x/node/types"
+	nodetypes "github.com/sentinel-official/hub/v12/x/node/types/v3"
+	sessionkeys "github.com/sentinel-official/hub/v12/x/session/types"
+	sessiontypes "github.com/sentinel-official/hub/v12/x/session/types/v3"
+)
+
+// NextBlock produces an empty block, committing the state set since the previous block.
+// The committed state can be verified by a light client once the following block is produced, as the app hash of
+// a block is only included in the header of the next one.
+func (ch *Chain) NextBlock() {
+	ch.mu.Lock()
+	defer ch.mu.Unlock()
+
+	ch.commitBlock()
+}
+
+// TrustOptions returns the options for trusting the first block of the chain with the given trusting period,
+// to be passed to client.NewLightClient.
+func (ch *Chain) TrustOptions(period time.Duration) light.TrustOptions {
+	ch.mu.Lock()
+	defer ch.mu.Unlock()
+
+	return light.TrustOptions{
+		Period: period,
+		Height: 1,
+		Hash:   ch.headers[0].Hash(),
+	}
+}
+
+// vpnKey returns the key of the given module key in the store of the vpn module, where the hub keeps the state
+// of the node and session modules under a prefix named after each module.
+func vpnKey(module string, key []byte) []byte {
+	return append([]byte(module+"/"), key...)
+}
+
+// storeAccount writes the given account to the store of the auth module, encoded as an interface like the auth
+// module does.
+func (ch *Chain) storeAccount(account auth.AccountI) {
+	value, err := ch.protoCodec.MarshalInterface(account)
+	if err != nil {
+		panic(fmt.Errorf("failed to marshal account: %w", err))
+	}
+
+	store := ch.store.GetKVStore(ch.accStoreKey)
+	store.Set(auth.AddressStoreKey(account.GetAddress()), value)
+}
+
+// storeNode writes the given node to the store under the key of its status, removing the key of the other status.
+func (ch *Chain) storeNode(node nodetypes.Node) {
+	addr := node.GetAddress()
+	activeKey := vpnKey(nodekeys.ModuleName, nodekeys.ActiveNodeKey(addr))
+	inactiveKey := vpnKey(nodekeys.ModuleName, nodekeys.InactiveNodeKey(addr))
+
+	key, staleKey := inactiveKey, activeKey
+	if node.Status == v1base.StatusActive {
+		key, staleKey = activeKey, inactiveKey
+	}
+
+	store := ch.store.GetKVStore(ch.vpnStoreKey)
+	store.Delete(staleKey)
+	store.Set(key, ch.protoCodec.MustMarshal(&node))
+}
+
+// storeSession writes the given session to the store, encoded as an interface like the hub does.
+func (ch *Chain) storeSession(session sessiontypes.Session) {
+	value, err := ch.protoCodec.MarshalInterface(session)
+	if err != nil {
+		panic(fmt.Errorf("failed to marshal session: %w", err))
+	}
+
+	store := ch.store.GetKVStore(ch.vpnStoreKey)
+	store.Set(vpnKey(sessionkeys.ModuleName, sessionkeys.SessionKey(session.GetID())), value)
+}
+
+// commitBlock commits the state to a new block, signed by the single validator of the chain.
+// The header of the block carries the app hash of the state committed by the previous block.
+func (ch *Chain) commitBlock() {
+	appHash := ch.store.LastCommitID().Hash
+	commitID := ch.store.Commit()
+
+	// Block times must strictly increase for the light client to accept the headers.
+	now := time.Now().UTC()
+	var lastBlockID cmttypes.BlockID
+	if n := len(ch.headers); n > 0 {
+		last := ch.headers[n-1]
+		if !now.After(last.Time) {
+			now = last.Time.Add(time.Nanosecond)
+		}
+
+		lastBlockID = last.Commit.BlockID
+	}
+
+	header := &cmttypes.Header{
+		Version:            cmtversion.Consensus{Block: version.BlockProtocol},
+		ChainID:            ch.chainID,
+		Height:             commitID.Version,
+		Time:               now,
+		LastBlockID:        lastBlockID,
+		ValidatorsHash:     ch.valSet.Hash(),
+		NextValidatorsHash: ch.valSet.Hash(),
+		AppHash:            appHash,
+		ProposerAddress:    ch.valSet.Proposer.Address,
+	}
+
+	blockID := cmttypes.BlockID{
+		Hash:          header.Hash(),
+		PartSetHeader: cmttypes.PartSetHeader{Total: 1, Hash: header.Hash()},
+	}
+
+	voteSet := cmttypes.NewVoteSet(ch.chainID, header.Height, 0, cmtproto.PrecommitType, ch.valSet)
+	commit, err := cmttypes.MakeCommit(blockID, header.Height, 0, voteSet, []cmttypes.PrivValidator{ch.privVal}, now)
+	if err != nil {
+		panic(fmt.Errorf("failed to sign block %d: %w", header.Height, err))
+	}
+
+	ch.height = header.Height
+	ch.headers = append(ch.headers, &cmttypes.SignedHeader{Header: header, Commit: commit})
+}
+
+// blockHeight resolves the requested height of a block, where nil stands for the latest block.
+// Returns an error if the chain has no block at that height.
+func (ch *Chain) blockHeight(height *int64) (int64, error) {
+	if height == nil || *height == 0 {
+		return ch.height, nil
+	}
+	if *height < 1 || *height > ch.height {
+		return 0, fmt.Errorf("height %d must be between 1 and the current blockchain height %d", *height, ch.height)
+	}
+
+	return *height, nil
+}
+
+// commit serves the commit route with the signed header of the block at the given height.
+func (ch *Chain) commit(_ *rpctypes.Context, heightPtr *int64) (*core.ResultCommit, error) {
+	ch.mu.Lock()
+	defer ch.mu.Unlock()
+
+	height, err := ch.blockHeight(heightPtr)
+	if err != nil {
+		return nil, err
+	}
+
+	return core.NewResultCommit(ch.headers[height-1].Header, ch.headers[height-1].Commit, true), nil
+}
+
+// validators serves the validators route with the single validator of the chain, in a single page.
+func (ch *Chain) validators(_ *rpctypes.Context, heightPtr *int64, _, _ *int) (*core.ResultValidators, error) {
+	ch.mu.Lock()
+	defer ch.mu.Unlock()
+
+	height, err := ch.blockHeight(heightPtr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &core.ResultValidators{
+		BlockHeight: height,
+		Validators:  ch.valSet.Validators,
+		Count:       ch.valSet.Size(),
+		Total:       ch.valSet.Size(),
+	}, nil
+}
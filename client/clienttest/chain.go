@@ -0,0 +1,615 @@
+// Package clienttest provides an in-process stand-in for a Sentinel chain, for testing code built on client.Client.
+package clienttest
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	dbm "github.com/cometbft/cometbft-db"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/libs/bytes"
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cometbft/cometbft/mempool"
+	"github.com/cometbft/cometbft/p2p"
+	core "github.com/cometbft/cometbft/rpc/core/types"
+	rpcserver "github.com/cometbft/cometbft/rpc/jsonrpc/server"
+	rpctypes "github.com/cometbft/cometbft/rpc/jsonrpc/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	cosmossdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	auth "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+	leasetypes "github.com/sentinel-official/hub/v12/x/lease/types/v1"
+	nodetypes "github.com/sentinel-official/hub/v12/x/node/types/v3"
+	plantypes "github.com/sentinel-official/hub/v12/x/plan/types/v3"
+	sessiontypes "github.com/sentinel-official/hub/v12/x/session/types/v3"
+	subscriptiontypes "github.com/sentinel-official/hub/v12/x/subscription/types/v3"
+	vpntypes "github.com/sentinel-official/hub/v12/x/vpn/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/sentinel-official/sentinel-go-sdk/types"
+)
+
+// Gas reported as used by simulated and executed transactions
+const simulateGasUsed = 100_000
+
+// Chain is an in-process stand-in for a chain. It serves the abci_query, broadcast_tx_sync, commit, tx, status and
+// validators JSON-RPC routes used by client.Client and the light client from in-memory state seeded by the test.
+// Broadcast transactions are checked against the minimum gas prices, the signer sequences and the allowance of
+// their fee granter, as during CheckTx. Every accepted transaction is included in a block of its own. Its messages
+// are recorded but not executed, so tests update the state through the Set methods to reflect their effects.
+// Accounts, nodes and sessions are also written to a committed store, whose store key queries carry proofs against the
+// app hash of blocks signed by a single validator.
+type Chain struct {
+	chainID     string
+	protoCodec  codec.ProtoCodecMarshaler
+	txConfig    client.TxConfig
+	server      *httptest.Server
+	privVal     cmttypes.PrivValidator
+	valSet      *cmttypes.ValidatorSet
+	accStoreKey *storetypes.KVStoreKey
+	vpnStoreKey *storetypes.KVStoreKey
+
+	mu                sync.Mutex
+	height            int64
+	headers           []*cmttypes.SignedHeader
+	store             *rootmulti.Store
+	nextAccountNumber uint64
+	accounts          map[string]auth.AccountI
+	allowances        map[string]feegrant.Grant
+	leases            map[uint64]leasetypes.Lease
+	nodes             map[string]nodetypes.Node
+	plans             map[uint64]plantypes.Plan
+	sessions          map[uint64]sessiontypes.Session
+	subscriptions     map[uint64]subscriptiontypes.Subscription
+	txs               map[string]*core.ResultTx
+	msgs              []cosmossdk.Msg
+	minGasPrices      cosmossdk.DecCoins
+	nextBroadcastErr  error
+	nextBroadcastLag  time.Duration
+	nextCheckTxErr    *errorsmod.Error
+	nextCheckTxLog    string
+	nextDeliverTxErr  *errorsmod.Error
+	nextDeliverTxLog  string
+}
+
+// NewChain starts a chain with the given chain ID, serving the JSON-RPC routes on a local address.
+// The chain must be closed once the test is done.
+func NewChain(chainID string) *Chain {
+	protoCodec := types.NewProtoCodec()
+	privVal := cmttypes.NewMockPV()
+	pubKey, err := privVal.GetPubKey()
+	if err != nil {
+		panic(fmt.Errorf("failed to get validator pubkey: %w", err))
+	}
+
+	ch := &Chain{
+		chainID:       chainID,
+		protoCodec:    protoCodec,
+		txConfig:      authtx.NewTxConfig(protoCodec, authtx.DefaultSignModes),
+		privVal:       privVal,
+		valSet:        cmttypes.NewValidatorSet([]*cmttypes.Validator{cmttypes.NewValidator(pubKey, 10)}),
+		accStoreKey:   storetypes.NewKVStoreKey(auth.StoreKey),
+		vpnStoreKey:   storetypes.NewKVStoreKey(vpntypes.StoreKey),
+		store:         rootmulti.NewStore(dbm.NewMemDB(), log.NewNopLogger()),
+		accounts:      make(map[string]auth.AccountI),
+		allowances:    make(map[string]feegrant.Grant),
+		leases:        make(map[uint64]leasetypes.Lease),
+		nodes:         make(map[string]nodetypes.Node),
+		plans:         make(map[uint64]plantypes.Plan),
+		sessions:      make(map[uint64]sessiontypes.Session),
+		subscriptions: make(map[uint64]subscriptiontypes.Subscription),
+		txs:           make(map[string]*core.ResultTx),
+	}
+
+	// Mount the stores of the auth and vpn modules and commit the first block.
+	ch.store.MountStoreWithDB(ch.accStoreKey, storetypes.StoreTypeIAVL, nil)
+	ch.store.MountStoreWithDB(ch.vpnStoreKey, storetypes.StoreTypeIAVL, nil)
+	if err := ch.store.LoadLatestVersion(); err != nil {
+		panic(fmt.Errorf("failed to load store: %w", err))
+	}
+
+	ch.commitBlock()
+
+	routes := map[string]*rpcserver.RPCFunc{
+		"abci_query":        rpcserver.NewRPCFunc(ch.abciQuery, "path,data,height,prove"),
+		"broadcast_tx_sync": rpcserver.NewRPCFunc(ch.broadcastTxSync, "tx"),
+		"commit":            rpcserver.NewRPCFunc(ch.commit, "height"),
+		"status":            rpcserver.NewRPCFunc(ch.status, ""),
+		"tx":                rpcserver.NewRPCFunc(ch.tx, "hash,prove"),
+		"validators":        rpcserver.NewRPCFunc(ch.validators, "height,page,per_page"),
+	}
+
+	mux := http.NewServeMux()
+	rpcserver.RegisterRPCFuncs(mux, routes, log.NewNopLogger())
+
+	ch.server = httptest.NewServer(mux)
+	return ch
+}
+
+// Close shuts down the JSON-RPC server of the chain.
+func (ch *Chain) Close() {
+	ch.server.Close()
+}
+
+// URL returns the address of the JSON-RPC server, to be passed to client.Client.WithRPCAddr.
+func (ch *Chain) URL() string {
+	return ch.server.URL
+}
+
+// ChainID returns the chain ID of the chain.
+func (ch *Chain) ChainID() string {
+	return ch.chainID
+}
+
+// ProtoCodec returns the protobuf codec used by the chain.
+func (ch *Chain) ProtoCodec() codec.ProtoCodecMarshaler {
+	return ch.protoCodec
+}
+
+// TxConfig returns the transaction configuration used by the chain to decode transactions.
+func (ch *Chain) TxConfig() client.TxConfig {
+	return ch.txConfig
+}
+
+// Height returns the height of the latest block.
+func (ch *Chain) Height() int64 {
+	ch.mu.Lock()
+	defer ch.mu.Unlock()
+
+	return ch.height
+}
+
+// AddAccount creates a base account for the given address with the next account number and a zero sequence.
+// Returns the created account.
+func (ch *Chain) AddAccount(accAddr cosmossdk.AccAddress) auth.AccountI {
+	ch.mu.Lock()
+	defer ch.mu.Unlock()
+
+	account := auth.NewBaseAccount(accAddr, nil, ch.nextAccountNumber, 0)
+	ch.nextAccountNumber++
+
+	ch.accounts[accAddr.String()] = account
+	ch.storeAccount(account)
+
+	return account
+}
+
+// SetAccount stores the given account, replacing any account with the same address.
+// The account is committed to the store with the next block.
+func (ch *Chain) SetAccount(account auth.AccountI) {
+	ch.mu.Lock()
+	defer ch.mu.Unlock()
+
+	ch.accounts[account.GetAddress().String()] = account
+	ch.storeAccount(account)
+}
+
+// Account returns the account with the given address, or nil if it does not exist.
+func (ch *Chain) Account(accAddr cosmossdk.AccAddress) auth.AccountI {
+	ch.mu.Lock()
+	defer ch.mu.Unlock()
+
+	return ch.accounts[accAddr.String()]
+}
+
+// SetAllowance stores the fee allowance issued by the granter to the grantee, replacing any allowance between them.
+func (ch *Chain) SetAllowance(granterAddr, granteeAddr cosmossdk.AccAddress, allowance feegrant.FeeAllowanceI) {
+	ch.mu.Lock()
+	defer ch.mu.Unlock()
+
+	grant, err := feegrant.NewGrant(granterAddr, granteeAddr, allowance)
+	if err != nil {
+		panic(fmt.Errorf("failed to create grant: %w", err))
+	}
+
+	ch.allowances[allowanceKey(grant.Granter, grant.Grantee)] = grant
+}
+
+// RemoveAllowance removes the fee allowance issued by the granter to the grantee, as happens once it is revoked
+// or pruned after expiring.
+func (ch *Chain) RemoveAllowance(granterAddr, granteeAddr cosmossdk.AccAddress) {
+	ch.mu.Lock()
+	defer ch.mu.Unlock()
+
+	delete(ch.allowances, allowanceKey(granterAddr.String(), granteeAddr.String()))
+}
+
+// SetLease stores the given lease, replacing any lease with the same ID.
+func (ch *Chain) SetLease(lease leasetypes.Lease) {
+	ch.mu.Lock()
+	defer ch.mu.Unlock()
+
+	ch.leases[lease.ID] = lease
+}
+
+// SetNode stores the given node, replacing any node with the same address.
+// The node is committed to the store with the next block.
+func (ch *Chain) SetNode(node nodetypes.Node) {
+	ch.mu.Lock()
+	defer ch.mu.Unlock()
+
+	ch.nodes[node.Address] = node
+	ch.storeNode(node)
+}
+
+// SetPlan stores the given plan, replacing any plan with the same ID.
+func (ch *Chain) SetPlan(plan plantypes.Plan) {
+	ch.mu.Lock()
+	defer ch.mu.Unlock()
+
+	ch.plans[plan.ID] = plan
+}
+
+// SetSession stores the given session, replacing any session with the same ID.
+// The session is committed to the store with the next block.
+func (ch *Chain) SetSession(session sessiontypes.Session) {
+	ch.mu.Lock()
+	defer ch.mu.Unlock()
+
+	ch.sessions[session.GetID()] = session
+	ch.storeSession(session)
+}
+
+// SetSubscription stores the given subscription, replacing any subscription with the same ID.
+func (ch *Chain) SetSubscription(subscription subscriptiontypes.Subscription) {
+	ch.mu.Lock()
+	defer ch.mu.Unlock()
+
+	ch.subscriptions[subscription.ID] = subscription
+}
+
+// SetMinGasPrices sets the minimum gas prices reported by the node and required from broadcast transactions.
+func (ch *Chain) SetMinGasPrices(prices cosmossdk.DecCoins) {
+	ch.mu.Lock()
+	defer ch.mu.Unlock()
+
+	ch.minGasPrices = prices
+}
+
+// BroadcastMsgs returns the messages of all accepted transactions, in the order they were broadcast.
+func (ch *Chain) BroadcastMsgs() []cosmossdk.Msg {
+	ch.mu.Lock()
+	defer ch.mu.Unlock()
+
+	return slices.Clone(ch.msgs)
+}
+
+// FailNextBroadcast makes the node fail the next broadcast with the given error before running CheckTx, as it does
+// when its mempool is full.
+func (ch *Chain) FailNextBroadcast(err error) {
+	ch.mu.Lock()
+	defer ch.mu.Unlock()
+
+	ch.nextBroadcastErr = err
+}
+
+// DelayNextBroadcast makes the node delay its response to the next broadcast by the given duration, after the
+// transaction has been processed, as a node does when the request times out after reaching it.
+func (ch *Chain) DelayNextBroadcast(d time.Duration) {
+	ch.mu.Lock()
+	defer ch.mu.Unlock()
+
+	ch.nextBroadcastLag = d
+}
+
+// FailNextTx makes CheckTx reject the next broadcast transaction with the given error.
+func (ch *Chain) FailNextTx(err *errorsmod.Error, log string) {
+	ch.mu.Lock()
+	defer ch.mu.Unlock()
+
+	ch.nextCheckTxErr = err
+	ch.nextCheckTxLog = log
+}
+
+// FailNextDeliverTx makes the next accepted transaction fail during DeliverTx with the given error. The transaction
+// is still included in a block and advances the signer sequences.
+func (ch *Chain) FailNextDeliverTx(err *errorsmod.Error, log string) {
+	ch.mu.Lock()
+	defer ch.mu.Unlock()
+
+	ch.nextDeliverTxErr = err
+	ch.nextDeliverTxLog = log
+}
+
+// abciQuery serves the abci_query route. Store queries are answered from the committed store at the given height,
+// with a proof if requested, and gRPC queries are dispatched to their handler.
+func (ch *Chain) abciQuery(_ *rpctypes.Context, path string, data bytes.HexBytes, height int64, prove bool) (*core.ResultABCIQuery, error) {
+	ch.mu.Lock()
+	defer ch.mu.Unlock()
+
+	if strings.HasPrefix(path, "/store/") {
+		if height == 0 {
+			height = ch.height
+		}
+
+		req := abci.RequestQuery{
+			Path:   strings.TrimPrefix(path, "/store"),
+			Data:   data,
+			Height: height,
+			Prove:  prove,
+		}
+
+		return &core.ResultABCIQuery{Response: ch.store.Query(req)}, nil
+	}
+
+	handler, ok := ch.queryHandlers()[path]
+	if !ok {
+		return &core.ResultABCIQuery{
+			Response: queryErrorResponse(sdkerrors.ErrUnknownRequest, fmt.Sprintf("unknown query path %s", path), ch.height),
+		}, nil
+	}
+
+	resp, err := handler(data)
+	if err != nil {
+		return &core.ResultABCIQuery{
+			Response: queryErrorResponse(grpcErrorToSDKError(err), err.Error(), ch.height),
+		}, nil
+	}
+
+	value, err := ch.protoCodec.Marshal(resp)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal response: %w", err)
+	}
+
+	return &core.ResultABCIQuery{
+		Response: abci.ResponseQuery{
+			Value:  value,
+			Height: ch.height,
+		},
+	}, nil
+}
+
+// grpcErrorToSDKError returns the error the SDK reports over ABCI for the given gRPC status error of a query.
+func grpcErrorToSDKError(err error) *errorsmod.Error {
+	switch status.Code(err) {
+	case codes.NotFound:
+		return sdkerrors.ErrKeyNotFound
+	case codes.InvalidArgument, codes.FailedPrecondition:
+		return sdkerrors.ErrInvalidRequest
+	case codes.Unauthenticated:
+		return sdkerrors.ErrUnauthorized
+	default:
+		return sdkerrors.ErrUnknownRequest
+	}
+}
+
+// queryErrorResponse builds the response of a failed ABCI query the way the SDK reports query errors.
+func queryErrorResponse(err *errorsmod.Error, log string, height int64) abci.ResponseQuery {
+	return abci.ResponseQuery{
+		Codespace: err.Codespace(),
+		Code:      err.ABCICode(),
+		Log:       fmt.Sprintf("%s: %s", log, err.Error()),
+		Height:    height,
+	}
+}
+
+// rejectTx sets the given error as the CheckTx result of a broadcast transaction.
+// Returns the updated broadcast result.
+func rejectTx(res *core.ResultBroadcastTx, err *errorsmod.Error, log string) *core.ResultBroadcastTx {
+	res.Codespace = err.Codespace()
+	res.Code = err.ABCICode()
+	res.Log = fmt.Sprintf("%s: %s", log, err.Error())
+
+	return res
+}
+
+// broadcastTxSync serves the broadcast_tx_sync route, delaying the response if requested by the test.
+func (ch *Chain) broadcastTxSync(_ *rpctypes.Context, tx cmttypes.Tx) (*core.ResultBroadcastTx, error) {
+	ch.mu.Lock()
+	lag := ch.nextBroadcastLag
+	ch.nextBroadcastLag = 0
+	ch.mu.Unlock()
+
+	res, err := ch.checkTx(tx)
+	time.Sleep(lag)
+
+	return res, err
+}
+
+// checkTx runs the checks of CheckTx on a broadcast transaction. The signer sequences are checked and advanced, and
+// the transaction is included in a new block. Signatures are not verified.
+func (ch *Chain) checkTx(tx cmttypes.Tx) (*core.ResultBroadcastTx, error) {
+	ch.mu.Lock()
+	defer ch.mu.Unlock()
+
+	res := &core.ResultBroadcastTx{Hash: tx.Hash()}
+
+	// Fail the broadcast if requested by the test.
+	if ch.nextBroadcastErr != nil {
+		err := ch.nextBroadcastErr
+		ch.nextBroadcastErr = nil
+
+		return nil, err
+	}
+
+	// Refuse a transaction that was already received, as the mempool cache of the node does.
+	if _, ok := ch.txs[res.Hash.String()]; ok {
+		return nil, mempool.ErrTxInCache
+	}
+
+	// Reject the transaction if requested by the test.
+	if ch.nextCheckTxErr != nil {
+		err := ch.nextCheckTxErr
+		ch.nextCheckTxErr = nil
+
+		return rejectTx(res, err, ch.nextCheckTxLog), nil
+	}
+
+	decoded, err := ch.txConfig.TxDecoder()(tx)
+	if err != nil {
+		return rejectTx(res, sdkerrors.ErrTxDecode, err.Error()), nil
+	}
+
+	sigTx, ok := decoded.(authsigning.SigVerifiableTx)
+	if !ok {
+		return rejectTx(res, sdkerrors.ErrTxDecode, "invalid transaction type"), nil
+	}
+
+	// Check the fee against the minimum gas prices, as validators do during CheckTx.
+	feeTx, ok := decoded.(cosmossdk.FeeTx)
+	if !ok {
+		return rejectTx(res, sdkerrors.ErrTxDecode, "invalid transaction type"), nil
+	}
+
+	if !ch.minGasPrices.IsZero() {
+		gas := cosmossdk.NewDec(int64(feeTx.GetGas()))
+		required := make(cosmossdk.Coins, len(ch.minGasPrices))
+		for i, price := range ch.minGasPrices {
+			required[i] = cosmossdk.NewCoin(price.Denom, price.Amount.Mul(gas).Ceil().RoundInt())
+		}
+
+		if !feeTx.GetFee().IsAnyGTE(required) {
+			msg := fmt.Sprintf("insufficient fees; got: %s required: %s", feeTx.GetFee(), required)
+			return rejectTx(res, sdkerrors.ErrInsufficientFee, msg), nil
+		}
+	}
+
+	// Check that the fee granter, if any, has an allowance for the fee payer covering the fee.
+	if granter := feeTx.FeeGranter(); !granter.Empty() {
+		if err := ch.useAllowance(granter, feeTx.FeePayer(), feeTx.GetFee(), decoded.GetMsgs()); err != nil {
+			msg := fmt.Sprintf("%s does not allow to pay fees for %s: %s", granter, feeTx.FeePayer(), err)
+			return rejectTx(res, feegrant.ErrNoAllowance, msg), nil
+		}
+	}
+
+	// Check the sequence of each signer before advancing any of them.
+	sigs, err := sigTx.GetSignaturesV2()
+	if err != nil {
+		return rejectTx(res, sdkerrors.ErrTxDecode, err.Error()), nil
+	}
+
+	signers := sigTx.GetSigners()
+	if len(sigs) != len(signers) {
+		msg := fmt.Sprintf("wrong number of signatures; expected %d, got %d", len(signers), len(sigs))
+		return rejectTx(res, sdkerrors.ErrUnauthorized, msg), nil
+	}
+
+	accounts := make([]auth.AccountI, len(signers))
+	for i, signer := range signers {
+		account, ok := ch.accounts[signer.String()]
+		if !ok {
+			return rejectTx(res, sdkerrors.ErrUnknownAddress, fmt.Sprintf("account %s does not exist", signer)), nil
+		}
+		if sigs[i].Sequence != account.GetSequence() {
+			msg := fmt.Sprintf("account sequence mismatch, expected %d, got %d", account.GetSequence(), sigs[i].Sequence)
+			return rejectTx(res, sdkerrors.ErrWrongSequence, msg), nil
+		}
+
+		accounts[i] = account
+	}
+
+	for i, account := range accounts {
+		if account.GetPubKey() == nil && sigs[i].PubKey != nil {
+			_ = account.SetPubKey(sigs[i].PubKey)
+		}
+
+		_ = account.SetSequence(account.GetSequence() + 1)
+		ch.storeAccount(account)
+	}
+
+	txResult := abci.ResponseDeliverTx{
+		GasWanted: int64(feeTx.GetGas()),
+		GasUsed:   simulateGasUsed,
+	}
+
+	// Fail the transaction during DeliverTx if requested by the test, without recording its messages.
+	if ch.nextDeliverTxErr != nil {
+		txResult.Codespace = ch.nextDeliverTxErr.Codespace()
+		txResult.Code = ch.nextDeliverTxErr.ABCICode()
+		txResult.Log = fmt.Sprintf("%s: %s", ch.nextDeliverTxLog, ch.nextDeliverTxErr.Error())
+		ch.nextDeliverTxErr = nil
+	} else {
+		ch.msgs = append(ch.msgs, decoded.GetMsgs()...)
+	}
+
+	// Include the transaction in a new block.
+	ch.commitBlock()
+	ch.txs[res.Hash.String()] = &core.ResultTx{
+		Hash:     res.Hash,
+		Height:   ch.height,
+		Tx:       tx,
+		TxResult: txResult,
+	}
+
+	return res, nil
+}
+
+// useAllowance deducts the given fee from the allowance issued by the granter to the grantee, removing the
+// allowance once it is used up. Returns an error if there is no allowance or it does not cover the fee.
+// The caller must hold the lock.
+func (ch *Chain) useAllowance(granterAddr, granteeAddr cosmossdk.AccAddress, fee cosmossdk.Coins, msgs []cosmossdk.Msg) error {
+	key := allowanceKey(granterAddr.String(), granteeAddr.String())
+	grant, ok := ch.allowances[key]
+	if !ok {
+		return sdkerrors.ErrNotFound.Wrap("fee-grant not found")
+	}
+
+	allowance, err := grant.GetGrant()
+	if err != nil {
+		return err
+	}
+
+	ctx := cosmossdk.Context{}.
+		WithBlockTime(time.Now()).
+		WithGasMeter(storetypes.NewInfiniteGasMeter())
+
+	remove, err := allowance.Accept(ctx, fee, msgs)
+	if err != nil {
+		return err
+	}
+	if remove {
+		delete(ch.allowances, key)
+		return nil
+	}
+
+	grant, err = feegrant.NewGrant(granterAddr, granteeAddr, allowance)
+	if err != nil {
+		return err
+	}
+
+	ch.allowances[key] = grant
+	return nil
+}
+
+// tx serves the tx route with the result of an accepted transaction.
+func (ch *Chain) tx(_ *rpctypes.Context, hash []byte, _ bool) (*core.ResultTx, error) {
+	ch.mu.Lock()
+	defer ch.mu.Unlock()
+
+	res, ok := ch.txs[bytes.HexBytes(hash).String()]
+	if !ok {
+		return nil, fmt.Errorf("tx (%X) not found", hash)
+	}
+
+	return res, nil
+}
+
+// status serves the status route with the chain ID and the latest block height.
+func (ch *Chain) status(_ *rpctypes.Context) (*core.ResultStatus, error) {
+	ch.mu.Lock()
+	defer ch.mu.Unlock()
+
+	return &core.ResultStatus{
+		NodeInfo: p2p.DefaultNodeInfo{
+			Network: ch.chainID,
+		},
+		SyncInfo: core.SyncInfo{
+			LatestBlockHeight: ch.height,
+			LatestBlockTime:   time.Now(),
+		},
+	}, nil
+}
@@ -0,0 +1,257 @@
+package clienttest
+
+import (
+	"cmp"
+	"slices"
+
+	"github.com/cosmos/cosmos-sdk/client/grpc/node"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cosmossdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	auth "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+	v1base "github.com/sentinel-official/hub/v12/types/v1"
+	leasetypes "github.com/sentinel-official/hub/v12/x/lease/types/v1"
+	nodetypes "github.com/sentinel-official/hub/v12/x/node/types/v3"
+	plantypes "github.com/sentinel-official/hub/v12/x/plan/types/v3"
+	sessiontypes "github.com/sentinel-official/hub/v12/x/session/types/v3"
+	subscriptiontypes "github.com/sentinel-official/hub/v12/x/subscription/types/v3"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// queryHandler handles a protobuf encoded gRPC query request and returns its response.
+// Errors are gRPC status errors, as returned by the query servers of the chain.
+type queryHandler func(data []byte) (codec.ProtoMarshaler, error)
+
+// handleQuery wraps a function handling a typed query request into a queryHandler decoding the request.
+func handleQuery[T any, PT interface {
+	*T
+	codec.ProtoMarshaler
+}](fn func(req PT) (codec.ProtoMarshaler, error)) queryHandler {
+	return func(data []byte) (codec.ProtoMarshaler, error) {
+		req := PT(new(T))
+		if err := req.Unmarshal(data); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		return fn(req)
+	}
+}
+
+// sortedValues returns the values of the map accepted by keep, ordered by key.
+func sortedValues[K cmp.Ordered, V any](m map[K]V, keep func(V) bool) []V {
+	keys := make([]K, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+
+	slices.Sort(keys)
+
+	values := make([]V, 0, len(keys))
+	for _, k := range keys {
+		if keep(m[k]) {
+			values = append(values, m[k])
+		}
+	}
+
+	return values
+}
+
+// pageResponse returns the pagination details of a response listing all n items in a single page.
+func pageResponse(n int) *query.PageResponse {
+	return &query.PageResponse{Total: uint64(n)}
+}
+
+// hasStatus checks whether the given status matches the requested one, where an unspecified status matches all.
+func hasStatus(want, got v1base.Status) bool {
+	return want == v1base.StatusUnspecified || want == got
+}
+
+// allowanceKey returns the key of the fee allowance issued by the granter to the grantee.
+func allowanceKey(granter, grantee string) string {
+	return granter + "/" + grantee
+}
+
+// packSessions packs the given sessions into Any values, as returned by the session queries.
+func packSessions(sessions []sessiontypes.Session) ([]*codectypes.Any, error) {
+	items := make([]*codectypes.Any, len(sessions))
+	for i, session := range sessions {
+		item, err := codectypes.NewAnyWithValue(session)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		items[i] = item
+	}
+
+	return items, nil
+}
+
+// queryHandlers returns the handlers of the supported gRPC queries, keyed by method.
+// Lists are returned in a single page, ordered by ID or address. The caller must hold the lock.
+func (ch *Chain) queryHandlers() map[string]queryHandler {
+	return map[string]queryHandler{
+		"/cosmos.auth.v1beta1.Query/Account": handleQuery(func(req *auth.QueryAccountRequest) (codec.ProtoMarshaler, error) {
+			account, ok := ch.accounts[req.Address]
+			if !ok {
+				return nil, status.Errorf(codes.NotFound, "account %s not found", req.Address)
+			}
+
+			item, err := codectypes.NewAnyWithValue(account)
+			if err != nil {
+				return nil, status.Error(codes.Internal, err.Error())
+			}
+
+			return &auth.QueryAccountResponse{Account: item}, nil
+		}),
+		"/cosmos.feegrant.v1beta1.Query/Allowance": handleQuery(func(req *feegrant.QueryAllowanceRequest) (codec.ProtoMarshaler, error) {
+			grant, ok := ch.allowances[allowanceKey(req.Granter, req.Grantee)]
+			if !ok {
+				// The feegrant module reports missing allowances as internal errors.
+				return nil, status.Error(codes.Internal, sdkerrors.ErrNotFound.Wrap("fee-grant not found").Error())
+			}
+
+			return &feegrant.QueryAllowanceResponse{Allowance: &grant}, nil
+		}),
+		"/cosmos.feegrant.v1beta1.Query/Allowances": handleQuery(func(req *feegrant.QueryAllowancesRequest) (codec.ProtoMarshaler, error) {
+			grants := sortedValues(ch.allowances, func(v feegrant.Grant) bool { return v.Grantee == req.Grantee })
+
+			items := make([]*feegrant.Grant, len(grants))
+			for i := range grants {
+				items[i] = &grants[i]
+			}
+
+			return &feegrant.QueryAllowancesResponse{Allowances: items, Pagination: pageResponse(len(items))}, nil
+		}),
+		"/cosmos.base.node.v1beta1.Service/Config": handleQuery(func(_ *node.ConfigRequest) (codec.ProtoMarshaler, error) {
+			return &node.ConfigResponse{MinimumGasPrice: ch.minGasPrices.String()}, nil
+		}),
+		"/cosmos.tx.v1beta1.Service/Simulate": handleQuery(func(req *tx.SimulateRequest) (codec.ProtoMarshaler, error) {
+			decoded, err := ch.txConfig.TxDecoder()(req.TxBytes)
+			if err != nil {
+				return nil, status.Error(codes.InvalidArgument, err.Error())
+			}
+
+			var gasWanted uint64
+			if feeTx, ok := decoded.(cosmossdk.FeeTx); ok {
+				gasWanted = feeTx.GetGas()
+			}
+
+			return &tx.SimulateResponse{
+				GasInfo: &cosmossdk.GasInfo{GasWanted: gasWanted, GasUsed: simulateGasUsed},
+				Result:  &cosmossdk.Result{},
+			}, nil
+		}),
+		"/sentinel.lease.v1.QueryService/QueryLease": handleQuery(func(req *leasetypes.QueryLeaseRequest) (codec.ProtoMarshaler, error) {
+			lease, ok := ch.leases[req.Id]
+			if !ok {
+				return nil, status.Errorf(codes.NotFound, "lease %d does not exist", req.Id)
+			}
+
+			return &leasetypes.QueryLeaseResponse{Lease: lease}, nil
+		}),
+		"/sentinel.lease.v1.QueryService/QueryLeases": handleQuery(func(_ *leasetypes.QueryLeasesRequest) (codec.ProtoMarshaler, error) {
+			items := sortedValues(ch.leases, func(leasetypes.Lease) bool { return true })
+			return &leasetypes.QueryLeasesResponse{Leases: items, Pagination: pageResponse(len(items))}, nil
+		}),
+		"/sentinel.lease.v1.QueryService/QueryLeasesForNode": handleQuery(func(req *leasetypes.QueryLeasesForNodeRequest) (codec.ProtoMarshaler, error) {
+			items := sortedValues(ch.leases, func(v leasetypes.Lease) bool { return v.NodeAddress == req.Address })
+			return &leasetypes.QueryLeasesForNodeResponse{Leases: items, Pagination: pageResponse(len(items))}, nil
+		}),
+		"/sentinel.lease.v1.QueryService/QueryLeasesForProvider": handleQuery(func(req *leasetypes.QueryLeasesForProviderRequest) (codec.ProtoMarshaler, error) {
+			items := sortedValues(ch.leases, func(v leasetypes.Lease) bool { return v.ProvAddress == req.Address })
+			return &leasetypes.QueryLeasesForProviderResponse{Leases: items, Pagination: pageResponse(len(items))}, nil
+		}),
+		"/sentinel.node.v3.QueryService/QueryNode": handleQuery(func(req *nodetypes.QueryNodeRequest) (codec.ProtoMarshaler, error) {
+			node, ok := ch.nodes[req.Address]
+			if !ok {
+				return nil, status.Errorf(codes.NotFound, "node %s does not exist", req.Address)
+			}
+
+			return &nodetypes.QueryNodeResponse{Node: node}, nil
+		}),
+		"/sentinel.node.v3.QueryService/QueryNodes": handleQuery(func(req *nodetypes.QueryNodesRequest) (codec.ProtoMarshaler, error) {
+			items := sortedValues(ch.nodes, func(v nodetypes.Node) bool { return hasStatus(req.Status, v.Status) })
+			return &nodetypes.QueryNodesResponse{Nodes: items, Pagination: pageResponse(len(items))}, nil
+		}),
+		"/sentinel.plan.v3.QueryService/QueryPlan": handleQuery(func(req *plantypes.QueryPlanRequest) (codec.ProtoMarshaler, error) {
+			plan, ok := ch.plans[req.Id]
+			if !ok {
+				return nil, status.Errorf(codes.NotFound, "plan %d does not exist", req.Id)
+			}
+
+			return &plantypes.QueryPlanResponse{Plan: plan}, nil
+		}),
+		"/sentinel.plan.v3.QueryService/QueryPlans": handleQuery(func(req *plantypes.QueryPlansRequest) (codec.ProtoMarshaler, error) {
+			items := sortedValues(ch.plans, func(v plantypes.Plan) bool { return hasStatus(req.Status, v.Status) })
+			return &plantypes.QueryPlansResponse{Plans: items, Pagination: pageResponse(len(items))}, nil
+		}),
+		"/sentinel.plan.v3.QueryService/QueryPlansForProvider": handleQuery(func(req *plantypes.QueryPlansForProviderRequest) (codec.ProtoMarshaler, error) {
+			items := sortedValues(ch.plans, func(v plantypes.Plan) bool {
+				return v.ProvAddress == req.Address && hasStatus(req.Status, v.Status)
+			})
+			return &plantypes.QueryPlansForProviderResponse{Plans: items, Pagination: pageResponse(len(items))}, nil
+		}),
+		"/sentinel.session.v3.QueryService/QuerySession": handleQuery(func(req *sessiontypes.QuerySessionRequest) (codec.ProtoMarshaler, error) {
+			session, ok := ch.sessions[req.Id]
+			if !ok {
+				return nil, status.Errorf(codes.NotFound, "session %d does not exist", req.Id)
+			}
+
+			item, err := codectypes.NewAnyWithValue(session)
+			if err != nil {
+				return nil, status.Error(codes.Internal, err.Error())
+			}
+
+			return &sessiontypes.QuerySessionResponse{Session: item}, nil
+		}),
+		"/sentinel.session.v3.QueryService/QuerySessions": handleQuery(func(_ *sessiontypes.QuerySessionsRequest) (codec.ProtoMarshaler, error) {
+			items, err := packSessions(sortedValues(ch.sessions, func(sessiontypes.Session) bool { return true }))
+			if err != nil {
+				return nil, err
+			}
+
+			return &sessiontypes.QuerySessionsResponse{Sessions: items, Pagination: pageResponse(len(items))}, nil
+		}),
+		"/sentinel.session.v3.QueryService/QuerySessionsForAccount": handleQuery(func(req *sessiontypes.QuerySessionsForAccountRequest) (codec.ProtoMarshaler, error) {
+			items, err := packSessions(sortedValues(ch.sessions, func(v sessiontypes.Session) bool { return v.GetAccAddress() == req.Address }))
+			if err != nil {
+				return nil, err
+			}
+
+			return &sessiontypes.QuerySessionsForAccountResponse{Sessions: items, Pagination: pageResponse(len(items))}, nil
+		}),
+		"/sentinel.session.v3.QueryService/QuerySessionsForNode": handleQuery(func(req *sessiontypes.QuerySessionsForNodeRequest) (codec.ProtoMarshaler, error) {
+			items, err := packSessions(sortedValues(ch.sessions, func(v sessiontypes.Session) bool { return v.GetNodeAddress() == req.Address }))
+			if err != nil {
+				return nil, err
+			}
+
+			return &sessiontypes.QuerySessionsForNodeResponse{Sessions: items, Pagination: pageResponse(len(items))}, nil
+		}),
+		"/sentinel.subscription.v3.QueryService/QuerySubscription": handleQuery(func(req *subscriptiontypes.QuerySubscriptionRequest) (codec.ProtoMarshaler, error) {
+			subscription, ok := ch.subscriptions[req.Id]
+			if !ok {
+				return nil, status.Errorf(codes.NotFound, "subscription %d does not exist", req.Id)
+			}
+
+			return &subscriptiontypes.QuerySubscriptionResponse{Subscription: subscription}, nil
+		}),
+		"/sentinel.subscription.v3.QueryService/QuerySubscriptions": handleQuery(func(_ *subscriptiontypes.QuerySubscriptionsRequest) (codec.ProtoMarshaler, error) {
+			items := sortedValues(ch.subscriptions, func(subscriptiontypes.Subscription) bool { return true })
+			return &subscriptiontypes.QuerySubscriptionsResponse{Subscriptions: items, Pagination: pageResponse(len(items))}, nil
+		}),
+		"/sentinel.subscription.v3.QueryService/QuerySubscriptionsForAccount": handleQuery(func(req *subscriptiontypes.QuerySubscriptionsForAccountRequest) (codec.ProtoMarshaler, error) {
+			items := sortedValues(ch.subscriptions, func(v subscriptiontypes.Subscription) bool { return v.AccAddress == req.Address })
+			return &subscriptiontypes.QuerySubscriptionsForAccountResponse{Subscriptions: items, Pagination: pageResponse(len(items))}, nil
+		}),
+		"/sentinel.subscription.v3.QueryService/QuerySubscriptionsForPlan": handleQuery(func(req *subscriptiontypes.QuerySubscriptionsForPlanRequest) (codec.ProtoMarshaler, error) {
+			items := sortedValues(ch.subscriptions, func(v subscriptiontypes.Subscription) bool { return v.PlanID == req.Id })
+			return &subscriptiontypes.QuerySubscriptionsForPlanResponse{Subscriptions: items, Pagination: pageResponse(len(items))}, nil
+		}),
+	}
+}
@@ -0,0 +1,243 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/cometbft/cometbft/rpc/client/http"
+)
+
+const (
+	// Duration for which an endpoint that returned an error is considered unhealthy
+	endpointErrorCooldown = 30 * time.Second
+	// Number of blocks an endpoint may lag behind the highest observed block before it is considered unhealthy
+	endpointMaxHeightLag = 5
+	// Weight of the latest sample in the moving average of the endpoint latency
+	endpointLatencyWeight = 0.2
+	// Default interval after which the health of all endpoints is refreshed in the background
+	endpointRefreshInterval = 30 * time.Second
+)
+
+// EndpointHealth contains a snapshot of the health of an RPC endpoint.
+type EndpointHealth struct {
+	Addr        string        // Address of the RPC endpoint
+	Latency     time.Duration // Moving average of the request latency, zero if no request succeeded yet
+	LastError   error         // Last error returned by the endpoint
+	LastErrorAt time.Time     // Time at which the last error occurred
+	Height      int64         // Latest block height observed on the endpoint
+}
+
+// IsHealthy checks whether the endpoint has no recent errors and does not lag behind the given block height.
+func (h EndpointHealth) IsHealthy(maxHeight int64, now time.Time) bool {
+	if h.LastError != nil && now.Sub(h.LastErrorAt) < endpointErrorCooldown {
+		return false
+	}
+	if h.Height > 0 && maxHeight-h.Height > endpointMaxHeightLag {
+		return false
+	}
+
+	return true
+}
+
+// endpoint wraps an RPC endpoint together with its HTTP client and health information.
+type endpoint struct {
+	mu     sync.Mutex
+	http   *http.HTTP
+	health EndpointHealth
+}
+
+// newEndpoint creates an endpoint for the given RPC address.
+func newEndpoint(addr string) *endpoint {
+	return &endpoint{
+		health: EndpointHealth{Addr: addr},
+	}
+}
+
+// client returns the HTTP client of the endpoint, creating it on first use.
+func (e *endpoint) client(timeout time.Duration) (*http.HTTP, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.http != nil {
+		return e.http, nil
+	}
+
+	// Create the HTTP client with the configured timeout in seconds.
+	c, err := http.NewWithTimeout(e.health.Addr, "/websocket", uint(timeout/time.Second))
+	if err != nil {
+		return nil, err
+	}
+
+	e.http = c
+	return e.http, nil
+}
+
+// observe records the outcome of a request that was sent to the endpoint at the given start time.
+// Errors caused by the cancellation of the context are not attributed to the endpoint.
+func (e *endpoint) observe(ctx context.Context, start time.Time, height int64, err error) {
+	if err != nil && ctx.Err() != nil {
+		return
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	now := time.Now()
+	if err != nil {
+		e.health.LastError = err
+		e.health.LastErrorAt = now
+		return
+	}
+
+	// Update the moving average of the latency.
+	latency := now.Sub(start)
+	if e.health.Latency == 0 {
+		e.health.Latency = latency
+	} else {
+		e.health.Latency = time.Duration((1-endpointLatencyWeight)*float64(e.health.Latency) + endpointLatencyWeight*float64(latency))
+	}
+
+	if height > e.health.Height {
+		e.health.Height = height
+	}
+
+	e.health.LastError = nil
+}
+
+// snapshot returns a copy of the health information of the endpoint.
+func (e *endpoint) snapshot() EndpointHealth {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	return e.health
+}
+
+// endpoint selects the healthiest of the configured RPC endpoints.
+// Healthy endpoints are ordered by latency, with untried endpoints preferred. If no endpoint is healthy,
+// the one whose last error is the oldest is returned.
+// The block heights of the endpoints are taken from the responses to their requests, and from the background
+// refreshes of all endpoints, so that an endpoint lagging behind the others is noticed without being queried.
+func (c *Client) endpoint() (*endpoint, error) {
+	if len(c.rpcEndpoints) == 0 {
+		return nil, errors.New("no rpc endpoints configured")
+	}
+
+	c.refreshEndpointsInBackground()
+
+	// Take a snapshot of all endpoints and find the highest observed block.
+	var (
+		maxHeight int64
+		now       = time.Now()
+		snapshots = make([]EndpointHealth, len(c.rpcEndpoints))
+	)
+
+	for i, e := range c.rpcEndpoints {
+		snapshots[i] = e.snapshot()
+		maxHeight = max(maxHeight, snapshots[i].Height)
+	}
+
+	// Pick the healthy endpoint with the lowest latency.
+	best := -1
+	for i, s := range snapshots {
+		if !s.IsHealthy(maxHeight, now) {
+			continue
+		}
+		if best == -1 || s.Latency < snapshots[best].Latency {
+			best = i
+		}
+	}
+
+	// Fall back to the endpoint which has been failing for the longest time.
+	if best == -1 {
+		best = 0
+		for i, s := range snapshots {
+			if s.LastErrorAt.Before(snapshots[best].LastErrorAt) {
+				best = i
+			}
+		}
+	}
+
+	return c.rpcEndpoints[best], nil
+}
+
+// rpc selects the healthiest RPC endpoint and returns it together with its HTTP client.
+func (c *Client) rpc() (*endpoint, *http.HTTP, error) {
+	e, err := c.endpoint()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	http, err := e.client(c.rpcTimeout)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return e, http, nil
+}
+
+// Endpoints returns a snapshot of the health of all configured RPC endpoints.
+func (c *Client) Endpoints() []EndpointHealth {
+	items := make([]EndpointHealth, len(c.rpcEndpoints))
+	for i, e := range c.rpcEndpoints {
+		items[i] = e.snapshot()
+	}
+
+	return items
+}
+
+// refreshEndpointsInBackground starts a refresh of all RPC endpoints without waiting for it, if the refresh
+// interval has passed since the previous one. Nothing is refreshed when there is a single endpoint, as there is
+// no other endpoint to fail over to.
+func (c *Client) refreshEndpointsInBackground() {
+	if c.rpcRefreshInterval <= 0 || len(c.rpcEndpoints) < 2 {
+		return
+	}
+
+	c.rpcRefreshMu.Lock()
+	defer c.rpcRefreshMu.Unlock()
+
+	now := time.Now()
+	if now.Sub(c.rpcRefreshedAt) < c.rpcRefreshInterval {
+		return
+	}
+
+	c.rpcRefreshedAt = now
+	go func() {
+		// Bound the refresh so that it is done before the next one starts.
+		ctx, cancel := context.WithTimeout(context.Background(), c.rpcRefreshInterval)
+		defer cancel()
+
+		c.RefreshEndpoints(ctx)
+	}()
+}
+
+// RefreshEndpoints queries the status of every configured RPC endpoint to update its latency and block height.
+// Endpoints that fail to respond are marked as unhealthy.
+func (c *Client) RefreshEndpoints(ctx context.Context) {
+	var wg sync.WaitGroup
+	for _, e := range c.rpcEndpoints {
+		wg.Add(1)
+		go func(e *endpoint) {
+			defer wg.Done()
+
+			http, err := e.client(c.rpcTimeout)
+			if err != nil {
+				e.observe(ctx, time.Now(), 0, err)
+				return
+			}
+
+			start := time.Now()
+			res, err := http.Status(ctx)
+			if err != nil {
+				e.observe(ctx, start, 0, err)
+				return
+			}
+
+			e.observe(ctx, start, res.SyncInfo.LatestBlockHeight, nil)
+		}(e)
+	}
+
+	wg.Wait()
+}
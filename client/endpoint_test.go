@@ -0,0 +1,46 @@
+package client_test
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestLaggingEndpointIsSkipped(t *testing.T) {
+	lagging, synced := newTestChain(t), newTestChain(t)
+	for i := 0; i < 10; i++ {
+		synced.NextBlock()
+	}
+
+	c, _ := newTestClient(t, synced, "alice")
+	c = c.WithRPCAddrs(lagging.URL(), synced.URL()).WithRPCRefreshInterval(time.Hour)
+
+	// The first request starts a refresh of all endpoints in the background.
+	ctx := context.Background()
+	if _, err := c.LatestHeight(ctx); err != nil {
+		t.Fatalf("LatestHeight: %v", err)
+	}
+
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		endpoints := c.Endpoints()
+		if endpoints[0].Height > 0 && endpoints[1].Height > 0 {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("Endpoints = %v, want the heights of both endpoints refreshed", endpoints)
+		}
+
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	for i := 0; i < 3; i++ {
+		height, err := c.LatestHeight(ctx)
+		if err != nil {
+			t.Fatalf("LatestHeight: %v", err)
+		}
+		if height != synced.Height() {
+			t.Fatalf("LatestHeight = %d, want %d of the synced endpoint", height, synced.Height())
+		}
+	}
+}
@@ -0,0 +1,131 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+
+	"github.com/avast/retry-go/v4"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Sentinel errors classifying the failures of queries and transactions, to be checked with errors.Is.
+var (
+	ErrNotFound         = errors.New("not found")                 // Queried item, account or transaction does not exist
+	ErrSequenceMismatch = errors.New("account sequence mismatch") // Transaction was signed with a stale sequence
+	ErrInsufficientFee  = errors.New("insufficient fee")          // Fees are below the minimum required by the node
+	ErrMempoolFull      = errors.New("mempool is full")           // Mempool of the node cannot accept more transactions
+	ErrOutOfGas         = errors.New("out of gas")                // Transaction ran out of gas
+	ErrUnauthorized     = errors.New("unauthorized")              // Signer is not allowed to perform the action
+	ErrUnavailable      = errors.New("unavailable")               // Transient failure of the RPC or gRPC server
+)
+
+// sdkCodeErrors maps the codes of the SDK root codespace to the sentinel errors.
+var sdkCodeErrors = map[uint32]error{
+	sdkerrors.ErrInsufficientFee.ABCICode(): ErrInsufficientFee,
+	sdkerrors.ErrKeyNotFound.ABCICode():     ErrNotFound,
+	sdkerrors.ErrMempoolIsFull.ABCICode():   ErrMempoolFull,
+	sdkerrors.ErrNotFound.ABCICode():        ErrNotFound,
+	sdkerrors.ErrOutOfGas.ABCICode():        ErrOutOfGas,
+	sdkerrors.ErrUnauthorized.ABCICode():    ErrUnauthorized,
+	sdkerrors.ErrUnknownAddress.ABCICode():  ErrNotFound,
+	sdkerrors.ErrWrongSequence.ABCICode():   ErrSequenceMismatch,
+}
+
+// grpcCodeErrors maps the gRPC status codes to the sentinel errors.
+var grpcCodeErrors = map[codes.Code]error{
+	codes.Aborted:           ErrUnavailable,
+	codes.DeadlineExceeded:  ErrUnavailable,
+	codes.NotFound:          ErrNotFound,
+	codes.PermissionDenied:  ErrUnauthorized,
+	codes.ResourceExhausted: ErrUnavailable,
+	codes.Unauthenticated:   ErrUnauthorized,
+	codes.Unavailable:       ErrUnavailable,
+}
+
+// classifyABCIError returns the sentinel error for the given ABCI codespace and code, or nil if there is none.
+func classifyABCIError(codespace string, code uint32) error {
+	if codespace != sdkerrors.RootCodespace {
+		return nil
+	}
+
+	return sdkCodeErrors[code]
+}
+
+// ABCIError represents an error returned by the application for a query, identified by its codespace and code.
+type ABCIError struct {
+	Codespace string // Codespace of the ABCI error
+	Code      uint32 // Code of the ABCI error
+	Log       string // Log describing the error
+}
+
+// Error returns the string representation of the ABCI error.
+func (e *ABCIError) Error() string {
+	return fmt.Sprintf("query failed with codespace %s and code %d: %s", e.Codespace, e.Code, e.Log)
+}
+
+// Is checks whether the ABCI error is classified as the given sentinel error.
+func (e *ABCIError) Is(target error) bool {
+	err := classifyABCIError(e.Codespace, e.Code)
+	return err != nil && err == target
+}
+
+// GRPCError represents an error returned by a gRPC server, carrying its status.
+type GRPCError struct {
+	Status *status.Status // Status returned by the server
+}
+
+// Error returns the string representation of the gRPC error.
+func (e *GRPCError) Error() string {
+	return e.Status.Err().Error()
+}
+
+// GRPCStatus returns the status of the gRPC error, so that it can be retrieved with status.FromError.
+func (e *GRPCError) GRPCStatus() *status.Status {
+	return e.Status
+}
+
+// Is checks whether the gRPC error is classified as the given sentinel error.
+func (e *GRPCError) Is(target error) bool {
+	err, ok := grpcCodeErrors[e.Status.Code()]
+	return ok && err == target
+}
+
+// IsRetryable checks whether an operation which failed with the given error may succeed if retried.
+// Transient failures of the servers and full mempools are retryable, while errors returned by the application,
+// non-transient gRPC errors and errors marked with retry.Unrecoverable are not. Unclassified errors are retryable.
+func IsRetryable(err error) bool {
+	if errors.Is(err, ErrUnavailable) || errors.Is(err, ErrMempoolFull) {
+		return true
+	}
+	if !retry.IsRecoverable(err) {
+		return false
+	}
+
+	var (
+		abciErr *ABCIError
+		grpcErr *GRPCError
+		txErr   *TxError
+	)
+
+	return !errors.As(err, &abciErr) && !errors.As(err, &grpcErr) && !errors.As(err, &txErr)
+}
+
+// isTimeoutError checks whether the given error indicates that a request timed out.
+func isTimeoutError(err error) bool {
+	if errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+
+	var netErr net.Error
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
+
+// isTransientBroadcastError checks whether a broadcast failed because the mempool was full or the request
+// timed out, in which case it may succeed if retried.
+func isTransientBroadcastError(err error) bool {
+	return errors.Is(err, ErrMempoolFull) || isTimeoutError(err)
+}
@@ -0,0 +1,382 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/libs/bytes"
+	"github.com/cometbft/cometbft/rpc/client/http"
+	core "github.com/cometbft/cometbft/rpc/core/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	cosmossdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+	sentinelhub "github.com/sentinel-official/hub/v12/types"
+	leasetypes "github.com/sentinel-official/hub/v12/x/lease/types/v1"
+	nodetypes "github.com/sentinel-official/hub/v12/x/node/types/v3"
+	subscriptiontypes "github.com/sentinel-official/hub/v12/x/subscription/types/v3"
+)
+
+const (
+	// Name under which event subscriptions are registered with the RPC server
+	eventsSubscriber = "sentinel-go-sdk"
+	// Capacity of the channels buffering events between the RPC server and the consumer
+	eventsChanCapacity = 100
+	// Interval between liveness checks of the RPC endpoint serving the subscriptions
+	eventsHealthInterval = 30 * time.Second
+	// Bounds of the interval between attempts to re-establish the subscriptions
+	eventsRetryMinInterval = time.Second
+	eventsRetryMaxInterval = 30 * time.Second
+)
+
+// errEventsHandlerDone is returned by streamEvents once the handler no longer accepts results.
+var errEventsHandlerDone = errors.New("events handler done")
+
+// EventQuery describes a typed event emitted by a transaction, or while finalizing a block, optionally filtered
+// by its attributes.
+type EventQuery struct {
+	Type       string            // Fully qualified name of the typed event, such as sentinel.node.v3.EventCreateSession
+	Attributes map[string]string // Values the attributes of the event must have, keyed by attribute name
+	Block      bool              // Flag for events emitted while beginning or ending blocks rather than by transactions
+}
+
+// NewEventQuery creates a query matching all typed events of the same type as the given message emitted by
+// transactions.
+func NewEventQuery(event proto.Message) EventQuery {
+	return EventQuery{
+		Type:       proto.MessageName(event),
+		Attributes: make(map[string]string),
+	}
+}
+
+// NewBlockEventQuery creates a query matching all typed events of the same type as the given message emitted
+// while beginning or ending blocks, such as those of the begin and end blockers of the modules.
+func NewBlockEventQuery(event proto.Message) EventQuery {
+	q := NewEventQuery(event)
+	q.Block = true
+
+	return q
+}
+
+// With returns a copy of the query that additionally requires the given attribute to have the given value.
+func (q EventQuery) With(key, value string) EventQuery {
+	attrs := make(map[string]string, len(q.Attributes)+1)
+	for k, v := range q.Attributes {
+		attrs[k] = v
+	}
+
+	attrs[key] = value
+	return EventQuery{
+		Type:       q.Type,
+		Attributes: attrs,
+		Block:      q.Block,
+	}
+}
+
+// encodeEventAttributeValue encodes a value the way attributes of typed events are encoded, as a JSON string.
+func encodeEventAttributeValue(value string) string {
+	buf, _ := json.Marshal(value)
+	return string(buf)
+}
+
+// conditions returns the conditions on the attributes of the event in the syntax of the CometBFT queries,
+// ordered by attribute name.
+func (q EventQuery) conditions() []string {
+	keys := make([]string, 0, len(q.Attributes))
+	for k := range q.Attributes {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	conditions := make([]string, 0, len(keys))
+	for _, k := range keys {
+		conditions = append(conditions, fmt.Sprintf("%s.%s='%s'", q.Type, k, encodeEventAttributeValue(q.Attributes[k])))
+	}
+
+	return conditions
+}
+
+// String returns the query in the syntax of the CometBFT event subscriptions, subscribing to new blocks for block
+// events and to transactions otherwise.
+func (q EventQuery) String() string {
+	eventType := cmttypes.EventTx
+	if q.Block {
+		eventType = cmttypes.EventNewBlock
+	}
+
+	conditions := append([]string{fmt.Sprintf("%s='%s'", cmttypes.EventTypeKey, eventType)}, q.conditions()...)
+	return strings.Join(conditions, " AND ")
+}
+
+// SearchString returns the query in the syntax of the CometBFT transaction and block searches.
+// The searches only index the attributes of events, so the query must require at least one attribute.
+// Returns the query string or an error if the query has no attributes.
+func (q EventQuery) SearchString() (string, error) {
+	conditions := q.conditions()
+	if len(conditions) == 0 {
+		return "", fmt.Errorf("event query %s has no attributes to search by", q.Type)
+	}
+
+	return strings.Join(conditions, " AND "), nil
+}
+
+// Matches checks whether the given ABCI event is of the type of the query and carries the required attributes.
+func (q EventQuery) Matches(event abci.Event) bool {
+	if event.Type != q.Type {
+		return false
+	}
+
+	for k, v := range q.Attributes {
+		value := encodeEventAttributeValue(v)
+
+		found := false
+		for _, attr := range event.Attributes {
+			if attr.Key == k && attr.Value == value {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
+
+// SessionStartedForNodeQueries returns the queries matching sessions started on the given node,
+// both directly and through subscriptions or plans.
+func SessionStartedForNodeQueries(nodeAddr sentinelhub.NodeAddress) []EventQuery {
+	return []EventQuery{
+		NewEventQuery(&nodetypes.EventCreateSession{}).With("node_address", nodeAddr.String()),
+		NewEventQuery(&subscriptiontypes.EventCreateSession{}).With("node_address", nodeAddr.String()),
+	}
+}
+
+// SessionStartedForAccountQueries returns the queries matching sessions started by the given account,
+// both directly on nodes and through subscriptions.
+func SessionStartedForAccountQueries(accAddr cosmossdk.AccAddress) []EventQuery {
+	return []EventQuery{
+		NewEventQuery(&nodetypes.EventCreateSession{}).With("acc_address", accAddr.String()),
+		NewEventQuery(&subscriptiontypes.EventCreateSession{}).With("acc_address", accAddr.String()),
+	}
+}
+
+// NodePaidQueries returns the queries matching payments to the given node, both for sessions started directly
+// on the node and for leases. Payments are emitted by the end blockers rather than by transactions.
+func NodePaidQueries(nodeAddr sentinelhub.NodeAddress) []EventQuery {
+	return []EventQuery{
+		NewBlockEventQuery(&nodetypes.EventPay{}).With("node_address", nodeAddr.String()),
+		NewBlockEventQuery(&leasetypes.EventPay{}).With("node_address", nodeAddr.String()),
+	}
+}
+
+// SubscriptionCreatedForAccountQuery returns the query matching subscriptions created by the given account.
+func SubscriptionCreatedForAccountQuery(accAddr cosmossdk.AccAddress) EventQuery {
+	return NewEventQuery(&subscriptiontypes.EventCreate{}).With("acc_address", accAddr.String())
+}
+
+// LeaseEndedQueries returns the queries matching all ended leases, both those ended by a transaction and those
+// ended by the end blocker once they expire.
+func LeaseEndedQueries() []EventQuery {
+	return []EventQuery{
+		NewEventQuery(&leasetypes.EventEnd{}),
+		NewBlockEventQuery(&leasetypes.EventEnd{}),
+	}
+}
+
+// Event contains a typed event emitted by a transaction, or while finalizing a block, which matched a query.
+type Event struct {
+	Query   EventQuery     // Query matched by the event
+	Height  int64          // Height of the block including the transaction
+	TxHash  bytes.HexBytes // Hash of the transaction which emitted the event, empty for block events
+	Message proto.Message  // Decoded typed event, such as *nodetypes.EventCreateSession
+}
+
+// SubscribeEvents subscribes to the typed events matching any of the given queries and delivers them on
+// the returned channel. Block event queries subscribe to new blocks and deliver the events emitted while
+// beginning and ending each block. The subscriptions are served by the healthiest RPC endpoint and re-established,
+// on another endpoint if necessary, whenever the connection is lost. Events emitted while the subscriptions
+// are being re-established are missed. Failures are recorded in the health of the endpoints.
+// The channel is closed once the context is done.
+func (c *Client) SubscribeEvents(ctx context.Context, queries ...EventQuery) (<-chan Event, error) {
+	if len(queries) == 0 {
+		return nil, errors.New("no event queries given")
+	}
+
+	strs := make([]string, len(queries))
+	for i, q := range queries {
+		strs[i] = q.String()
+	}
+
+	out := make(chan Event, eventsChanCapacity)
+	go func() {
+		defer close(out)
+
+		c.subscribe(ctx, strs, func(res core.ResultEvent) bool {
+			return forwardEvents(ctx, queries, res, out)
+		})
+	}()
+
+	return out, nil
+}
+
+// subscribe passes the results of the given subscription queries to handle until the context is done or handle
+// returns false, re-establishing the subscriptions with an increasing delay whenever the connection is lost.
+func (c *Client) subscribe(ctx context.Context, queries []string, handle func(core.ResultEvent) bool) {
+	interval := eventsRetryMinInterval
+	for {
+		// Stream the results until the connection is lost or the context is done.
+		subscribed, err := c.streamEvents(ctx, queries, handle)
+		if subscribed {
+			interval = eventsRetryMinInterval
+		}
+		if errors.Is(err, errEventsHandlerDone) {
+			return
+		}
+
+		// Wait before re-establishing the subscriptions, doubling the interval up to the maximum.
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(interval):
+		}
+
+		interval = min(2*interval, eventsRetryMaxInterval)
+	}
+}
+
+// streamEvents subscribes to the given queries on the healthiest RPC endpoint and passes the results to handle
+// until the connection is lost, the context is done, or handle returns false.
+// Returns whether the subscriptions were established, and the error which ended the stream.
+func (c *Client) streamEvents(ctx context.Context, queries []string, handle func(core.ResultEvent) bool) (bool, error) {
+	endpoint, err := c.endpoint()
+	if err != nil {
+		return false, err
+	}
+
+	// Use a dedicated client, so that stopping its websocket connection does not affect other requests.
+	start := time.Now()
+	client, err := http.NewWithTimeout(endpoint.snapshot().Addr, "/websocket", uint(c.rpcTimeout/time.Second))
+	if err != nil {
+		return false, err
+	}
+	if err := client.Start(); err != nil {
+		endpoint.observe(ctx, start, 0, err)
+		return false, fmt.Errorf("failed to start websocket client: %w", err)
+	}
+
+	defer func() { _ = client.Stop() }()
+
+	// Subscribe to each query and merge the results into a single channel.
+	results := make(chan core.ResultEvent, eventsChanCapacity)
+	done := make(chan struct{})
+	defer close(done)
+
+	for _, q := range queries {
+		ch, err := client.Subscribe(ctx, eventsSubscriber, q, eventsChanCapacity)
+		if err != nil {
+			endpoint.observe(ctx, start, 0, err)
+			return false, fmt.Errorf("failed to subscribe to %s: %w", q, err)
+		}
+
+		go func(ch <-chan core.ResultEvent) {
+			for {
+				select {
+				case <-done:
+					return
+				case res := <-ch:
+					select {
+					case <-done:
+						return
+					case results <- res:
+					}
+				}
+			}
+		}(ch)
+	}
+
+	endpoint.observe(ctx, start, 0, nil)
+
+	ticker := time.NewTicker(eventsHealthInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return true, ctx.Err()
+		case <-ticker.C:
+			// Check that the endpoint is still alive, as a lost websocket connection is not reported.
+			start := time.Now()
+			status, err := client.Status(ctx)
+			if err != nil {
+				endpoint.observe(ctx, start, 0, err)
+				return true, fmt.Errorf("failed to query status: %w", err)
+			}
+
+			endpoint.observe(ctx, start, status.SyncInfo.LatestBlockHeight, nil)
+		case res := <-results:
+			if !handle(res) {
+				return true, errEventsHandlerDone
+			}
+		}
+	}
+}
+
+// forwardEvents decodes the typed events of a transaction or a new block that match any of the queries and sends
+// them on out. Events of a new block are those emitted while beginning and ending it.
+// Returns false if the context is done before all events have been sent.
+func forwardEvents(ctx context.Context, queries []EventQuery, res core.ResultEvent, out chan<- Event) bool {
+	var (
+		block  bool
+		events []abci.Event
+		height int64
+		txHash bytes.HexBytes
+	)
+
+	switch data := res.Data.(type) {
+	case cmttypes.EventDataTx:
+		events, height, txHash = data.Result.Events, data.Height, cmttypes.Tx(data.Tx).Hash()
+	case cmttypes.EventDataNewBlock:
+		block, height = true, data.Block.Height
+		events = append(events, data.ResultBeginBlock.Events...)
+		events = append(events, data.ResultEndBlock.Events...)
+	default:
+		return true
+	}
+
+	for _, event := range events {
+		for _, q := range queries {
+			if q.Block != block || !q.Matches(event) {
+				continue
+			}
+
+			// Skip events which cannot be decoded into a registered type.
+			msg, err := cosmossdk.ParseTypedEvent(event)
+			if err != nil {
+				break
+			}
+
+			select {
+			case <-ctx.Done():
+				return false
+			case out <- Event{
+				Query:   q,
+				Height:  height,
+				TxHash:  txHash,
+				Message: msg,
+			}:
+			}
+
+			break
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,246 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client/grpc/node"
+	cosmossdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// gRPC methods for querying node information
+	methodQueryConfig = "/cosmos.base.node.v1beta1.Service/Config" // Endpoint for retrieving the node configuration
+)
+
+// MinGasPrices retrieves the minimum gas prices accepted by the node using a gRPC query.
+// Returns the minimum gas prices, empty if the node accepts transactions without fees, and any potential error.
+func (c *Client) MinGasPrices(ctx context.Context) (cosmossdk.DecCoins, error) {
+	var (
+		resp node.ConfigResponse
+		req  = &node.ConfigRequest{}
+	)
+
+	// Perform the gRPC query to fetch the node configuration.
+	if err := c.QueryGRPC(ctx, methodQueryConfig, req, &resp); err != nil {
+		return nil, err
+	}
+
+	// Parse the minimum gas prices, dropping zero prices.
+	prices, err := cosmossdk.ParseDecCoins(resp.MinimumGasPrice)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse minimum gas prices: %w", err)
+	}
+
+	return prices, nil
+}
+
+// FeePriority represents how far above the minimum gas price a transaction is willing to pay.
+type FeePriority byte
+
+const (
+	FeePriorityLow     FeePriority = iota // Pay the minimum gas price
+	FeePriorityAverage                    // Pay slightly above the minimum gas price
+	FeePriorityHigh                       // Pay well above the minimum gas price
+)
+
+// String returns the string representation of the fee priority.
+func (p FeePriority) String() string {
+	switch p {
+	case FeePriorityLow:
+		return "low"
+	case FeePriorityAverage:
+		return "average"
+	case FeePriorityHigh:
+		return "high"
+	default:
+		return fmt.Sprintf("unknown(%d)", byte(p))
+	}
+}
+
+// defaultFeePriorityMultipliers are the default multipliers applied to the minimum gas price for each priority.
+var defaultFeePriorityMultipliers = map[FeePriority]cosmossdk.Dec{
+	FeePriorityLow:     cosmossdk.OneDec(),
+	FeePriorityAverage: cosmossdk.NewDecWithPrec(125, 2),
+	FeePriorityHigh:    cosmossdk.NewDecWithPrec(150, 2),
+}
+
+// FeeEstimator estimates transaction fees from the minimum gas prices accepted by the node.
+// Fees are paid in a single denom, the first preferred denom accepted by the node, at the minimum gas price
+// multiplied according to the priority and capped at the maximum fees.
+type FeeEstimator struct {
+	denoms            []string                      // Preferred fee denoms, in order of preference
+	fallbackGasPrices cosmossdk.DecCoins            // Gas prices used if the node accepts transactions without fees
+	maxFees           cosmossdk.Coins               // Maximum fees per denom, uncapped if not set
+	multipliers       map[FeePriority]cosmossdk.Dec // Multipliers applied to the minimum gas price, by priority
+	priority          FeePriority                   // Priority of the transactions
+	refreshInterval   time.Duration                 // Interval after which the minimum gas prices are looked up again
+
+	mu           sync.Mutex
+	minGasPrices cosmossdk.DecCoins // Cached minimum gas prices of the node
+	refreshedAt  time.Time          // Time at which the minimum gas prices were looked up
+}
+
+// NewFeeEstimator creates a fee estimator with average priority, looking up the minimum gas prices every minute.
+func NewFeeEstimator() *FeeEstimator {
+	multipliers := make(map[FeePriority]cosmossdk.Dec, len(defaultFeePriorityMultipliers))
+	for priority, multiplier := range defaultFeePriorityMultipliers {
+		multipliers[priority] = multiplier
+	}
+
+	return &FeeEstimator{
+		multipliers:     multipliers,
+		priority:        FeePriorityAverage,
+		refreshInterval: time.Minute,
+	}
+}
+
+// WithDenoms sets the preferred fee denoms, in order of preference, and returns the updated FeeEstimator.
+// If not set, fees are paid in the first denom accepted by the node.
+func (e *FeeEstimator) WithDenoms(denoms ...string) *FeeEstimator {
+	e.denoms = denoms
+	return e
+}
+
+// WithFallbackGasPrices sets the gas prices used if the node accepts transactions without fees and returns the
+// updated FeeEstimator.
+func (e *FeeEstimator) WithFallbackGasPrices(prices cosmossdk.DecCoins) *FeeEstimator {
+	e.fallbackGasPrices = prices
+	return e
+}
+
+// WithMaxFees sets the maximum fees per denom and returns the updated FeeEstimator.
+// Once set, fees can only be paid in the denoms with a maximum.
+func (e *FeeEstimator) WithMaxFees(fees cosmossdk.Coins) *FeeEstimator {
+	e.maxFees = fees
+	return e
+}
+
+// WithPriority sets the priority of the transactions and returns the updated FeeEstimator.
+func (e *FeeEstimator) WithPriority(priority FeePriority) *FeeEstimator {
+	e.priority = priority
+	return e
+}
+
+// WithPriorityMultiplier sets the multiplier applied to the minimum gas price for the given priority and returns
+// the updated FeeEstimator.
+func (e *FeeEstimator) WithPriorityMultiplier(priority FeePriority, multiplier cosmossdk.Dec) *FeeEstimator {
+	e.multipliers[priority] = multiplier
+	return e
+}
+
+// WithRefreshInterval sets the interval after which the minimum gas prices are looked up again and returns the
+// updated FeeEstimator. A zero interval looks them up for every transaction.
+func (e *FeeEstimator) WithRefreshInterval(interval time.Duration) *FeeEstimator {
+	e.refreshInterval = interval
+	return e
+}
+
+// gasPrices returns the cached minimum gas prices of the node, looking them up again once stale.
+func (e *FeeEstimator) gasPrices(ctx context.Context, lookup func(context.Context) (cosmossdk.DecCoins, error)) (cosmossdk.DecCoins, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.refreshedAt.IsZero() || time.Since(e.refreshedAt) >= e.refreshInterval {
+		prices, err := lookup(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		e.minGasPrices = prices
+		e.refreshedAt = time.Now()
+	}
+
+	return e.minGasPrices, nil
+}
+
+// selectGasPrice returns the minimum gas price of the first preferred denom among the given prices, or the first
+// price if there are no preferred denoms. Only denoms with a maximum fee are eligible once maximum fees are set.
+func (e *FeeEstimator) selectGasPrice(prices cosmossdk.DecCoins) (cosmossdk.DecCoin, error) {
+	eligible := func(denom string) bool {
+		return prices.AmountOf(denom).IsPositive() && (e.maxFees.Empty() || e.maxFees.AmountOf(denom).IsPositive())
+	}
+
+	if len(e.denoms) == 0 {
+		for _, price := range prices {
+			if eligible(price.Denom) {
+				return price, nil
+			}
+		}
+	}
+
+	for _, denom := range e.denoms {
+		if eligible(denom) {
+			return cosmossdk.NewDecCoinFromDec(denom, prices.AmountOf(denom)), nil
+		}
+	}
+
+	return cosmossdk.DecCoin{}, fmt.Errorf("no eligible fee denom among gas prices %s", prices)
+}
+
+// Fees estimates the fees of a transaction with the given gas limit from the minimum gas prices of the node.
+// Returns no fees if neither the node nor the fallback gas prices require any, or an error if no accepted denom is
+// eligible or the minimum fee exceeds the maximum.
+func (e *FeeEstimator) Fees(minGasPrices cosmossdk.DecCoins, gasLimit uint64) (cosmossdk.Coins, error) {
+	prices := minGasPrices
+	if prices.IsZero() {
+		prices = e.fallbackGasPrices
+	}
+	if prices.IsZero() {
+		return nil, nil
+	}
+
+	price, err := e.selectGasPrice(prices)
+	if err != nil {
+		return nil, err
+	}
+
+	// Scale the minimum gas price according to the priority.
+	multiplier, ok := e.multipliers[e.priority]
+	if !ok {
+		return nil, fmt.Errorf("no multiplier for fee priority %s", e.priority)
+	}
+
+	minFee := calculateFees(cosmossdk.DecCoins{price}, gasLimit)[0]
+	price.Amount = price.Amount.Mul(multiplier)
+	fee := calculateFees(cosmossdk.DecCoins{price}, gasLimit)[0]
+	if e.maxFees.Empty() {
+		return cosmossdk.NewCoins(fee), nil
+	}
+
+	// Cap the fee at the maximum, which must cover at least the minimum fee.
+	maxFee := cosmossdk.NewCoin(price.Denom, e.maxFees.AmountOf(price.Denom))
+	if maxFee.IsLT(minFee) {
+		return nil, fmt.Errorf("minimum fee %s exceeds maximum fee %s", minFee, maxFee)
+	}
+	if maxFee.IsLT(fee) {
+		fee = maxFee
+	}
+
+	return cosmossdk.NewCoins(fee), nil
+}
+
+// WithTxFeeEstimator sets the estimator of transaction fees and returns the updated Client.
+// When set, it takes precedence over the configured fees and gas prices of transactions broadcast online.
+func (c *Client) WithTxFeeEstimator(estimator *FeeEstimator) *Client {
+	c.txFeeEstimator = estimator
+	return c
+}
+
+// estimateTxFees estimates the fees of a transaction with the given gas limit using the fee estimator.
+// Returns the estimated fees or an error if the minimum gas prices cannot be retrieved or no fee is acceptable.
+func (c *Client) estimateTxFees(ctx context.Context, gasLimit uint64) (cosmossdk.Coins, error) {
+	prices, err := c.txFeeEstimator.gasPrices(ctx, c.MinGasPrices)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query min gas prices: %w", err)
+	}
+
+	fees, err := c.txFeeEstimator.Fees(prices, gasLimit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to estimate fees: %w", err)
+	}
+
+	return fees, nil
+}
@@ -0,0 +1,333 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	core "github.com/cometbft/cometbft/rpc/core/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	cosmossdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+	"google.golang.org/grpc/codes"
+)
+
+const (
+	// gRPC methods for querying fee allowances
+	methodQueryAllowance           = "/cosmos.feegrant.v1beta1.Query/Allowance"           // Endpoint for retrieving the allowance between a granter and a grantee
+	methodQueryAllowances          = "/cosmos.feegrant.v1beta1.Query/Allowances"          // Endpoint for listing allowances received by a grantee
+	methodQueryAllowancesByGranter = "/cosmos.feegrant.v1beta1.Query/AllowancesByGranter" // Endpoint for listing allowances issued by a granter
+)
+
+// isNoAllowanceError checks whether the given error of an Allowance query reports that the queried fee allowance
+// does not exist. The feegrant module returns the not found error of its keeper as an internal gRPC error, the only
+// error it reports for a well-formed request, which the ABCI query handler converts to an unknown request error.
+// The error is therefore classified by its codespace and code, or by its gRPC status code.
+func isNoAllowanceError(err error) bool {
+	if errors.Is(err, ErrNotFound) {
+		return true
+	}
+
+	var abciErr *ABCIError
+	if errors.As(err, &abciErr) {
+		return abciErr.Codespace == sdkerrors.RootCodespace && abciErr.Code == sdkerrors.ErrUnknownRequest.ABCICode()
+	}
+
+	var grpcErr *GRPCError
+	if errors.As(err, &grpcErr) {
+		return grpcErr.Status.Code() == codes.Internal
+	}
+
+	return false
+}
+
+// unpackGrants unpacks the allowances of the given grants with the interface registry of the codec, as the query
+// responses carrying them do not unpack their interfaces when decoded.
+// Returns an error if an allowance cannot be unpacked.
+func (c *Client) unpackGrants(grants ...*feegrant.Grant) error {
+	for _, grant := range grants {
+		if grant == nil {
+			continue
+		}
+		if err := grant.UnpackInterfaces(c.protoCodec.InterfaceRegistry()); err != nil {
+			return fmt.Errorf("failed to unpack allowance: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// Allowance retrieves the fee allowance issued by the granter to the grantee using a gRPC query.
+// Returns the allowance grant, nil if the granter issued no allowance to the grantee, and any potential error
+// encountered.
+func (c *Client) Allowance(ctx context.Context, granterAddr, granteeAddr cosmossdk.AccAddress) (*feegrant.Grant, error) {
+	var (
+		resp feegrant.QueryAllowanceResponse
+		req  = &feegrant.QueryAllowanceRequest{
+			Granter: granterAddr.String(),
+			Grantee: granteeAddr.String(),
+		}
+	)
+
+	// Perform the gRPC query to fetch the allowance.
+	if err := c.QueryGRPC(ctx, methodQueryAllowance, req, &resp); err != nil {
+		if isNoAllowanceError(err) {
+			return nil, nil
+		}
+
+		return nil, IsNotFoundError(err)
+	}
+
+	if err := c.unpackGrants(resp.Allowance); err != nil {
+		return nil, err
+	}
+
+	return resp.Allowance, nil
+}
+
+// Allowances retrieves the fee allowances received by the grantee with pagination support using a gRPC query.
+// Returns the allowance grants, pagination details, and any potential error.
+func (c *Client) Allowances(ctx context.Context, granteeAddr cosmossdk.AccAddress, pageReq *query.PageRequest) ([]*feegrant.Grant, *query.PageResponse, error) {
+	var (
+		resp feegrant.QueryAllowancesResponse
+		req  = &feegrant.QueryAllowancesRequest{
+			Grantee:    granteeAddr.String(),
+			Pagination: pageReq,
+		}
+	)
+
+	// Perform the gRPC query to fetch the paginated allowances.
+	if err := c.QueryGRPC(ctx, methodQueryAllowances, req, &resp); err != nil {
+		return nil, nil, err
+	}
+
+	if err := c.unpackGrants(resp.Allowances...); err != nil {
+		return nil, nil, err
+	}
+
+	return resp.Allowances, resp.Pagination, nil
+}
+
+// AllAllowances returns an iterator over all fee allowances received by the grantee.
+// Pages are fetched on demand according to the given options.
+func (c *Client) AllAllowances(ctx context.Context, granteeAddr cosmossdk.AccAddress, opts PageOptions) func(yield func(*feegrant.Grant, error) bool) {
+	return iteratePages(ctx, opts, func(ctx context.Context, pageReq *query.PageRequest) ([]*feegrant.Grant, *query.PageResponse, error) {
+		return c.Allowances(ctx, granteeAddr, pageReq)
+	})
+}
+
+// AllowancesByGranter retrieves the fee allowances issued by the granter with pagination support using a gRPC query.
+// Returns the allowance grants, pagination details, and any potential error.
+func (c *Client) AllowancesByGranter(ctx context.Context, granterAddr cosmossdk.AccAddress, pageReq *query.PageRequest) ([]*feegrant.Grant, *query.PageResponse, error) {
+	var (
+		resp feegrant.QueryAllowancesByGranterResponse
+		req  = &feegrant.QueryAllowancesByGranterRequest{
+			Granter:    granterAddr.String(),
+			Pagination: pageReq,
+		}
+	)
+
+	// Perform the gRPC query to fetch the paginated allowances.
+	if err := c.QueryGRPC(ctx, methodQueryAllowancesByGranter, req, &resp); err != nil {
+		return nil, nil, err
+	}
+
+	if err := c.unpackGrants(resp.Allowances...); err != nil {
+		return nil, nil, err
+	}
+
+	return resp.Allowances, resp.Pagination, nil
+}
+
+// AllAllowancesByGranter returns an iterator over all fee allowances issued by the granter.
+// Pages are fetched on demand according to the given options.
+func (c *Client) AllAllowancesByGranter(ctx context.Context, granterAddr cosmossdk.AccAddress, opts PageOptions) func(yield func(*feegrant.Grant, error) bool) {
+	return iteratePages(ctx, opts, func(ctx context.Context, pageReq *query.PageRequest) ([]*feegrant.Grant, *query.PageResponse, error) {
+		return c.AllowancesByGranter(ctx, granterAddr, pageReq)
+	})
+}
+
+// GrantAllowance grants the given fee allowance from the signing account to the grantee.
+// Returns the broadcast result and any error encountered.
+func (c *Client) GrantAllowance(ctx context.Context, granteeAddr cosmossdk.AccAddress, allowance feegrant.FeeAllowanceI) (*core.ResultBroadcastTx, error) {
+	granterAddr, err := c.FromAddr()
+	if err != nil {
+		return nil, err
+	}
+
+	// Build the message and broadcast it.
+	msg, err := feegrant.NewMsgGrantAllowance(allowance, granterAddr, granteeAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.BroadcastMsgs(ctx, msg)
+}
+
+// GrantBasicAllowance grants the grantee an allowance limited by the total spend limit and expiration.
+// A nil spend limit or expiration leaves the allowance unlimited in that respect.
+// Returns the broadcast result and any error encountered.
+func (c *Client) GrantBasicAllowance(ctx context.Context, granteeAddr cosmossdk.AccAddress, spendLimit cosmossdk.Coins, expiration *time.Time) (*core.ResultBroadcastTx, error) {
+	allowance := &feegrant.BasicAllowance{
+		SpendLimit: spendLimit,
+		Expiration: expiration,
+	}
+
+	return c.GrantAllowance(ctx, granteeAddr, allowance)
+}
+
+// GrantPeriodicAllowance grants the grantee an allowance which can spend up to periodSpendLimit within each period,
+// in addition to the total spend limit and expiration of a basic allowance.
+// Returns the broadcast result and any error encountered.
+func (c *Client) GrantPeriodicAllowance(ctx context.Context, granteeAddr cosmossdk.AccAddress, spendLimit cosmossdk.Coins, expiration *time.Time, period time.Duration, periodSpendLimit cosmossdk.Coins) (*core.ResultBroadcastTx, error) {
+	allowance := &feegrant.PeriodicAllowance{
+		Basic: feegrant.BasicAllowance{
+			SpendLimit: spendLimit,
+			Expiration: expiration,
+		},
+		Period:           period,
+		PeriodSpendLimit: periodSpendLimit,
+		PeriodCanSpend:   periodSpendLimit,
+		PeriodReset:      time.Now().Add(period),
+	}
+
+	return c.GrantAllowance(ctx, granteeAddr, allowance)
+}
+
+// GrantAllowedMsgAllowance grants the grantee the given allowance restricted to messages of the given type URLs.
+// Returns the broadcast result and any error encountered.
+func (c *Client) GrantAllowedMsgAllowance(ctx context.Context, granteeAddr cosmossdk.AccAddress, allowance feegrant.FeeAllowanceI, msgTypeURLs []string) (*core.ResultBroadcastTx, error) {
+	allowedMsgAllowance, err := feegrant.NewAllowedMsgAllowance(allowance, msgTypeURLs)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.GrantAllowance(ctx, granteeAddr, allowedMsgAllowance)
+}
+
+// RevokeAllowance revokes the fee allowance granted by the signing account to the grantee.
+// Returns the broadcast result and any error encountered.
+func (c *Client) RevokeAllowance(ctx context.Context, granteeAddr cosmossdk.AccAddress) (*core.ResultBroadcastTx, error) {
+	granterAddr, err := c.FromAddr()
+	if err != nil {
+		return nil, err
+	}
+
+	// Build the message and broadcast it.
+	msg := feegrant.NewMsgRevokeAllowance(granterAddr, granteeAddr)
+	return c.BroadcastMsgs(ctx, &msg)
+}
+
+// CheckAllowance checks whether the given allowance grant covers the fee of a transaction with the given messages.
+// The allowance is evaluated locally against the current time, mirroring the checks performed by the chain.
+// Returns an error describing why the allowance does not cover the fee.
+func CheckAllowance(grant *feegrant.Grant, fee cosmossdk.Coins, msgs []cosmossdk.Msg) error {
+	allowance, err := grant.GetGrant()
+	if err != nil {
+		return fmt.Errorf("failed to get allowance: %w", err)
+	}
+
+	// Evaluate the allowance with a context carrying the current time and an unlimited gas meter.
+	// Accept updates the allowance in place, which only affects the local copy.
+	ctx := cosmossdk.Context{}.
+		WithBlockTime(time.Now()).
+		WithGasMeter(storetypes.NewInfiniteGasMeter())
+	if _, err := allowance.Accept(ctx, fee, msgs); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// WithTxAutoFeeGranter sets whether the fee granter of each transaction is selected automatically from the
+// allowances received by the signing account, and returns the updated Client. The granter is selected for the fee
+// signed with the transaction, once gas simulation and fee estimation are done.
+func (c *Client) WithTxAutoFeeGranter(auto bool) *Client {
+	c.txAutoFeeGranter = auto
+	return c
+}
+
+// selectFeeGranter returns the fee granter to use for a transaction with the given fee and messages from the grantee.
+// Without automatic selection the configured fee granter is returned. Otherwise the configured fee granter is
+// preferred if its allowance covers the fee, followed by the first other granter whose allowance does.
+// A configured fee granter whose allowance is missing or cannot be queried is treated as not covering the fee.
+// Returns an error if automatic selection is enabled and no allowance covers the fee.
+func (c *Client) selectFeeGranter(ctx context.Context, granteeAddr cosmossdk.AccAddress, fee cosmossdk.Coins, msgs []cosmossdk.Msg) (cosmossdk.AccAddress, error) {
+	if !c.txAutoFeeGranter {
+		return c.txFeeGranterAddr, nil
+	}
+
+	var errs []error
+
+	// Prefer the configured fee granter if its allowance is still valid, and fall back to the other granters if it
+	// was revoked, pruned once expired, or cannot be queried.
+	if !c.txFeeGranterAddr.Empty() {
+		grant, err := c.Allowance(ctx, c.txFeeGranterAddr, granteeAddr)
+		if err == nil && grant == nil {
+			err = feegrant.ErrNoAllowance
+		}
+		if err == nil {
+			err = CheckAllowance(grant, fee, msgs)
+		}
+		if err == nil {
+			return c.txFeeGranterAddr, nil
+		}
+
+		errs = append(errs, fmt.Errorf("allowance from %s: %w", c.txFeeGranterAddr, err))
+	}
+
+	// Pick the first other granter whose allowance covers the fee, remembering why the others were rejected.
+	var (
+		granterAddr cosmossdk.AccAddress
+		queryErr    error
+	)
+
+	c.AllAllowances(ctx, granteeAddr, PageOptions{})(func(grant *feegrant.Grant, err error) bool {
+		if err != nil {
+			queryErr = fmt.Errorf("failed to query allowances: %w", err)
+			return false
+		}
+		if !c.txFeeGranterAddr.Empty() && grant.Granter == c.txFeeGranterAddr.String() {
+			return true
+		}
+		if err := CheckAllowance(grant, fee, msgs); err != nil {
+			errs = append(errs, fmt.Errorf("allowance from %s: %w", grant.Granter, err))
+			return true
+		}
+
+		granterAddr, err = cosmossdk.AccAddressFromBech32(grant.Granter)
+		if err != nil {
+			queryErr = fmt.Errorf("invalid granter addr %s: %w", grant.Granter, err)
+		}
+
+		return false
+	})
+
+	if queryErr != nil {
+		return nil, queryErr
+	}
+	if !granterAddr.Empty() {
+		return granterAddr, nil
+	}
+	if len(errs) == 0 {
+		return nil, fmt.Errorf("no fee allowances found for %s", granteeAddr)
+	}
+
+	return nil, fmt.Errorf("no fee allowance for %s covers fee %s: %w", granteeAddr, fee, errors.Join(errs...))
+}
+
+// setFeeGranter sets the fee granter of the transaction to the one selected for its current fee.
+// Returns an error if no fee granter can be selected.
+func (c *Client) setFeeGranter(ctx context.Context, txb client.TxBuilder, granteeAddr cosmossdk.AccAddress, msgs []cosmossdk.Msg) error {
+	granterAddr, err := c.selectFeeGranter(ctx, granteeAddr, txb.GetTx().GetFee(), msgs)
+	if err != nil {
+		return fmt.Errorf("failed to select fee granter: %w", err)
+	}
+
+	txb.SetFeeGranter(granterAddr)
+	return nil
+}
@@ -0,0 +1,152 @@
+package client_test
+
+import (
+	"context"
+	"testing"
+
+	cosmossdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+
+	"github.com/sentinel-official/sentinel-go-sdk/client"
+	"github.com/sentinel-official/sentinel-go-sdk/client/clienttest"
+)
+
+// newTestAllowance returns a basic allowance limited to spending the given amount of udvpn.
+func newTestAllowance(amount int64) *feegrant.BasicAllowance {
+	return &feegrant.BasicAllowance{
+		SpendLimit: cosmossdk.NewCoins(cosmossdk.NewInt64Coin("udvpn", amount)),
+	}
+}
+
+func TestAllowanceNotFound(t *testing.T) {
+	ch := newTestChain(t)
+	c, accAddr := newTestClient(t, ch, "alice")
+
+	grant, err := c.Allowance(context.Background(), cosmossdk.AccAddress(newTestNodeAddr(1)), accAddr)
+	if err != nil {
+		t.Fatalf("Allowance: %v", err)
+	}
+	if grant != nil {
+		t.Fatalf("Allowance = %v, want nil", grant)
+	}
+}
+
+func TestBroadcastTxFeeGranterFallback(t *testing.T) {
+	var (
+		configuredAddr = cosmossdk.AccAddress(newTestNodeAddr(1))
+		smallAddr      = cosmossdk.AccAddress(newTestNodeAddr(2))
+		largeAddr      = cosmossdk.AccAddress(newTestNodeAddr(3))
+	)
+
+	tests := []struct {
+		name  string
+		setup func(ch *clienttest.Chain, granteeAddr cosmossdk.AccAddress)
+		want  cosmossdk.AccAddress
+	}{
+		{
+			name: "configured granter covers the fee",
+			setup: func(ch *clienttest.Chain, granteeAddr cosmossdk.AccAddress) {
+				ch.SetAllowance(configuredAddr, granteeAddr, newTestAllowance(1_000))
+				ch.SetAllowance(largeAddr, granteeAddr, newTestAllowance(1_000))
+			},
+			want: configuredAddr,
+		},
+		{
+			name: "configured granter was revoked",
+			setup: func(ch *clienttest.Chain, granteeAddr cosmossdk.AccAddress) {
+				ch.SetAllowance(configuredAddr, granteeAddr, newTestAllowance(1_000))
+				ch.RemoveAllowance(configuredAddr, granteeAddr)
+				ch.SetAllowance(smallAddr, granteeAddr, newTestAllowance(10))
+				ch.SetAllowance(largeAddr, granteeAddr, newTestAllowance(1_000))
+			},
+			want: largeAddr,
+		},
+		{
+			name: "configured granter does not cover the fee",
+			setup: func(ch *clienttest.Chain, granteeAddr cosmossdk.AccAddress) {
+				ch.SetAllowance(configuredAddr, granteeAddr, newTestAllowance(10))
+				ch.SetAllowance(largeAddr, granteeAddr, newTestAllowance(1_000))
+			},
+			want: largeAddr,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ch := newTestChain(t)
+			c, accAddr := newTestClient(t, ch, "alice")
+			tt.setup(ch, accAddr)
+
+			c.WithTxFees(cosmossdk.NewCoins(cosmossdk.NewInt64Coin("udvpn", 100))).
+				WithTxFeeGranterAddr(configuredAddr).
+				WithTxAutoFeeGranter(true)
+
+			// The chain rejects transactions whose fee granter does not cover the fee.
+			if _, err := c.BroadcastTx(context.Background(), newTestMsgs(accAddr)); err != nil {
+				t.Fatalf("BroadcastTx: %v", err)
+			}
+
+			assertFeeGranterUsed(t, c, tt.want, accAddr)
+		})
+	}
+}
+
+// assertFeeGranterUsed checks that the fee of the last transaction of the grantee was deducted from the allowance of
+// the wanted granter, whose spend limit is reduced by the fee.
+func assertFeeGranterUsed(t *testing.T, c *client.Client, granterAddr, granteeAddr cosmossdk.AccAddress) {
+	t.Helper()
+
+	grant, err := c.Allowance(context.Background(), granterAddr, granteeAddr)
+	if err != nil {
+		t.Fatalf("Allowance: %v", err)
+	}
+
+	allowance, err := grant.GetGrant()
+	if err != nil {
+		t.Fatalf("failed to get allowance: %v", err)
+	}
+
+	limit := allowance.(*feegrant.BasicAllowance).SpendLimit
+	if !limit.IsEqual(cosmossdk.NewCoins(cosmossdk.NewInt64Coin("udvpn", 900))) {
+		t.Fatalf("spend limit of %s = %s, want the fee deducted from it", granterAddr, limit)
+	}
+}
+
+func TestBroadcastTxFeeGranterCoversSimulatedFee(t *testing.T) {
+	ch := newTestChain(t)
+	ch.SetMinGasPrices(cosmossdk.NewDecCoins(cosmossdk.NewDecCoin("udvpn", cosmossdk.NewInt(1))))
+
+	c, accAddr := newTestClient(t, ch, "alice")
+
+	// The first allowance covers the fee before simulation, when no gas is set, but not the final fee.
+	smallAddr := cosmossdk.AccAddress(newTestNodeAddr(1))
+	largeAddr := cosmossdk.AccAddress(newTestNodeAddr(2))
+	ch.SetAllowance(smallAddr, accAddr, newTestAllowance(1_000))
+	ch.SetAllowance(largeAddr, accAddr, newTestAllowance(1_000_000))
+
+	c.WithTxGas(0).
+		WithTxGasAdjustment(1.5).
+		WithTxSimulateAndExecute(true).
+		WithTxFeeEstimator(client.NewFeeEstimator().WithPriority(client.FeePriorityLow)).
+		WithTxAutoFeeGranter(true)
+
+	if _, err := c.BroadcastTx(context.Background(), newTestMsgs(accAddr)); err != nil {
+		t.Fatalf("BroadcastTx: %v", err)
+	}
+}
+
+func TestBroadcastTxNoFeeGranterCoversFee(t *testing.T) {
+	ch := newTestChain(t)
+	c, accAddr := newTestClient(t, ch, "alice")
+	ch.SetAllowance(cosmossdk.AccAddress(newTestNodeAddr(1)), accAddr, newTestAllowance(10))
+
+	c.WithTxFees(cosmossdk.NewCoins(cosmossdk.NewInt64Coin("udvpn", 100))).
+		WithTxAutoFeeGranter(true)
+
+	if _, err := c.BroadcastTx(context.Background(), newTestMsgs(accAddr)); err == nil {
+		t.Fatal("BroadcastTx succeeded, want an error as no allowance covers the fee")
+	}
+	if n := len(ch.BroadcastMsgs()); n != 0 {
+		t.Fatalf("chain received %d msgs, want 0", n)
+	}
+}
@@ -0,0 +1,283 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	core "github.com/cometbft/cometbft/rpc/core/types"
+	cosmossdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/gogoproto/proto"
+	sentinelhub "github.com/sentinel-official/hub/v12/types"
+)
+
+const (
+	// Order in which searched transactions and blocks are returned, from oldest to newest
+	searchOrderBy = "asc"
+)
+
+// DecodedTx contains a transaction included in a block, decoded into its body with typed messages.
+type DecodedTx struct {
+	TxResult          // Outcome of the transaction
+	Tx       *tx.Tx   // Decoded transaction, with its body, auth info and signatures
+	Err      *TxError // Error of the transaction if it failed during DeliverTx, nil otherwise
+}
+
+// Msgs returns the typed messages of the transaction body.
+func (t *DecodedTx) Msgs() []cosmossdk.Msg {
+	return t.Tx.GetMsgs()
+}
+
+// decodeTx decodes the given transaction included in a block, unpacking its messages with the proto codec.
+// Returns the decoded transaction or an error if decoding fails.
+func (c *Client) decodeTx(res *core.ResultTx) (*DecodedTx, error) {
+	var item tx.Tx
+	if err := c.protoCodec.Unmarshal(res.Tx, &item); err != nil {
+		return nil, fmt.Errorf("failed to decode tx %s: %w", res.Hash, err)
+	}
+
+	result, txErr := newTxResult(res)
+	return &DecodedTx{
+		TxResult: *result,
+		Tx:       &item,
+		Err:      txErr,
+	}, nil
+}
+
+// TxSearch searches the transactions matching the given query, in the syntax of the CometBFT transaction index,
+// such as "message.sender='sent1...'". Results are ordered from oldest to newest and paginated by page number,
+// starting at one, with perPage results per page or the server default if nil.
+// Returns the decoded transactions of the page, the total number of matching transactions, and any error.
+func (c *Client) TxSearch(ctx context.Context, query string, page int, perPage *int) ([]*DecodedTx, int, error) {
+	// Get the HTTP client of the healthiest endpoint for querying the blockchain.
+	endpoint, http, err := c.rpc()
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to create rpc client: %w", err)
+	}
+
+	// Perform the search.
+	start := time.Now()
+	res, err := http.TxSearch(ctx, query, c.queryProve, &page, perPage, searchOrderBy)
+	if err != nil {
+		endpoint.observe(ctx, start, 0, err)
+		return nil, 0, fmt.Errorf("failed to search txs: %w: %w", ErrUnavailable, err)
+	}
+
+	endpoint.observe(ctx, start, 0, nil)
+
+	// Decode each transaction of the page.
+	items := make([]*DecodedTx, len(res.Txs))
+	for i, item := range res.Txs {
+		items[i], err = c.decodeTx(item)
+		if err != nil {
+			return nil, 0, err
+		}
+	}
+
+	return items, res.TotalCount, nil
+}
+
+// AllTxSearch returns an iterator over all transactions matching the given query, from oldest to newest.
+// Pages are fetched on demand according to the given options.
+func (c *Client) AllTxSearch(ctx context.Context, query string, opts PageOptions) func(yield func(*DecodedTx, error) bool) {
+	return iterateNumberedPages(ctx, opts, func(ctx context.Context, page int, perPage *int) ([]*DecodedTx, int, error) {
+		return c.TxSearch(ctx, query, page, perPage)
+	})
+}
+
+// BlockSearch searches the blocks whose begin or end block events match the given query, in the syntax of the
+// CometBFT block index. Results are ordered from oldest to newest and paginated like those of TxSearch.
+// Returns the heights of the blocks of the page, the total number of matching blocks, and any error.
+func (c *Client) BlockSearch(ctx context.Context, query string, page int, perPage *int) ([]int64, int, error) {
+	// Get the HTTP client of the healthiest endpoint for querying the blockchain.
+	endpoint, http, err := c.rpc()
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to create rpc client: %w", err)
+	}
+
+	// Perform the search.
+	start := time.Now()
+	res, err := http.BlockSearch(ctx, query, &page, perPage, searchOrderBy)
+	if err != nil {
+		endpoint.observe(ctx, start, 0, err)
+		return nil, 0, fmt.Errorf("failed to search blocks: %w: %w", ErrUnavailable, err)
+	}
+
+	endpoint.observe(ctx, start, 0, nil)
+
+	heights := make([]int64, len(res.Blocks))
+	for i, item := range res.Blocks {
+		heights[i] = item.Block.Height
+	}
+
+	return heights, res.TotalCount, nil
+}
+
+// BlockEvents retrieves the events emitted while beginning and ending the block at the given height,
+// outside of any transaction.
+// Returns the events or an error.
+func (c *Client) BlockEvents(ctx context.Context, height int64) ([]abci.Event, error) {
+	// Get the HTTP client of the healthiest endpoint for querying the blockchain.
+	endpoint, http, err := c.rpc()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create rpc client: %w", err)
+	}
+
+	// Query the results of the block.
+	start := time.Now()
+	res, err := http.BlockResults(ctx, &height)
+	if err != nil {
+		endpoint.observe(ctx, start, 0, err)
+		return nil, fmt.Errorf("failed to query block results: %w: %w", ErrUnavailable, err)
+	}
+
+	endpoint.observe(ctx, start, 0, nil)
+
+	events := make([]abci.Event, 0, len(res.BeginBlockEvents)+len(res.EndBlockEvents))
+	events = append(events, res.BeginBlockEvents...)
+	events = append(events, res.EndBlockEvents...)
+
+	return events, nil
+}
+
+// matchTypedEvents decodes the events among the given ones which match the query.
+// Events which cannot be decoded into a registered type are skipped.
+func matchTypedEvents(q EventQuery, events []abci.Event) []proto.Message {
+	var items []proto.Message
+	for _, event := range events {
+		if !q.Matches(event) {
+			continue
+		}
+
+		item, err := cosmossdk.ParseTypedEvent(event)
+		if err != nil {
+			continue
+		}
+
+		items = append(items, item)
+	}
+
+	return items
+}
+
+// eventSource iterates over the events matching a search query, passing each height and transaction hash
+// along with the events to visit, until visit returns false.
+type eventSource func(ctx context.Context, query string, limit uint64, visit func(height int64, txHash []byte, events []abci.Event, err error) bool)
+
+// searchEvents returns an iterator over the typed events matching any of the given queries, found by the
+// blockSource for block event queries and by the txSource otherwise.
+// The queries are searched one after another, and the events of each are ordered from oldest to newest.
+// The Limit of the options sets the number of transactions or blocks fetched per page, and MaxItems the
+// maximum number of events yielded.
+func searchEvents(ctx context.Context, opts PageOptions, queries []EventQuery, txSource, blockSource eventSource) func(yield func(Event, error) bool) {
+	return func(yield func(Event, error) bool) {
+		var count uint64
+		for _, q := range queries {
+			// Stop once the maximum number of events has been yielded.
+			if opts.MaxItems > 0 && count >= opts.MaxItems {
+				return
+			}
+
+			query, err := q.SearchString()
+			if err != nil {
+				yield(Event{}, err)
+				return
+			}
+
+			source := txSource
+			if q.Block {
+				source = blockSource
+			}
+
+			done := false
+			source(ctx, query, opts.Limit, func(height int64, txHash []byte, events []abci.Event, err error) bool {
+				if err != nil {
+					yield(Event{}, err)
+					done = true
+					return false
+				}
+
+				for _, msg := range matchTypedEvents(q, events) {
+					if opts.MaxItems > 0 && count >= opts.MaxItems {
+						done = true
+						return false
+					}
+					if !yield(Event{Query: q, Height: height, TxHash: txHash, Message: msg}, nil) {
+						done = true
+						return false
+					}
+
+					count++
+				}
+
+				return true
+			})
+
+			if done {
+				return
+			}
+		}
+	}
+}
+
+// txEvents is the eventSource of the events emitted by the transactions matching a search query.
+func (c *Client) txEvents(ctx context.Context, query string, limit uint64, visit func(int64, []byte, []abci.Event, error) bool) {
+	c.AllTxSearch(ctx, query, PageOptions{Limit: limit})(func(item *DecodedTx, err error) bool {
+		if err != nil {
+			return visit(0, nil, nil, err)
+		}
+
+		return visit(item.Height, item.Hash, item.Events, nil)
+	})
+}
+
+// blockEvents is the eventSource of the events emitted while finalizing the blocks matching a search query.
+func (c *Client) blockEvents(ctx context.Context, query string, limit uint64, visit func(int64, []byte, []abci.Event, error) bool) {
+	fetch := func(ctx context.Context, page int, perPage *int) ([]int64, int, error) {
+		return c.BlockSearch(ctx, query, page, perPage)
+	}
+
+	iterateNumberedPages(ctx, PageOptions{Limit: limit}, fetch)(func(height int64, err error) bool {
+		if err != nil {
+			return visit(0, nil, nil, err)
+		}
+
+		events, err := c.BlockEvents(ctx, height)
+		if err != nil {
+			return visit(0, nil, nil, err)
+		}
+
+		return visit(height, nil, events, nil)
+	})
+}
+
+// SearchEvents returns an iterator over the typed events matching any of the given queries. Block event queries
+// search the blocks whose begin or end block events match, such as payments settled by the end blockers, and
+// other queries search transactions. The queries are searched one after another, and the events of each are
+// ordered from oldest to newest. Pages of transactions or blocks are fetched on demand, and MaxItems bounds the
+// number of events yielded.
+func (c *Client) SearchEvents(ctx context.Context, opts PageOptions, queries ...EventQuery) func(yield func(Event, error) bool) {
+	return searchEvents(ctx, opts, queries, c.txEvents, c.blockEvents)
+}
+
+// TxsBySender returns an iterator over the transactions with messages sent by the given account,
+// from oldest to newest. Pages are fetched on demand according to the given options.
+func (c *Client) TxsBySender(ctx context.Context, accAddr cosmossdk.AccAddress, opts PageOptions) func(yield func(*DecodedTx, error) bool) {
+	query := fmt.Sprintf("%s.%s='%s'", cosmossdk.EventTypeMessage, cosmossdk.AttributeKeySender, accAddr)
+	return c.AllTxSearch(ctx, query, opts)
+}
+
+// SessionStartsForAccount returns an iterator over the sessions started by the given account, yielding
+// *nodetypes.EventCreateSession events for sessions started directly on nodes, followed by
+// *subscriptiontypes.EventCreateSession events for sessions started through subscriptions.
+func (c *Client) SessionStartsForAccount(ctx context.Context, accAddr cosmossdk.AccAddress, opts PageOptions) func(yield func(Event, error) bool) {
+	return c.SearchEvents(ctx, opts, SessionStartedForAccountQueries(accAddr)...)
+}
+
+// PayoutsToNode returns an iterator over the payments to the given node, yielding *nodetypes.EventPay events
+// for sessions started directly on the node, followed by *leasetypes.EventPay events for leases.
+func (c *Client) PayoutsToNode(ctx context.Context, nodeAddr sentinelhub.NodeAddress, opts PageOptions) func(yield func(Event, error) bool) {
+	return c.SearchEvents(ctx, opts, NodePaidQueries(nodeAddr)...)
+}
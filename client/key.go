@@ -1,10 +1,13 @@
 package client
 
 import (
+	"bytes"
 	"fmt"
+	"slices"
 
 	"github.com/cosmos/cosmos-sdk/crypto/hd"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
 	"github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/go-bip39"
 )
@@ -94,3 +97,29 @@ func (c *Client) CreateKey(name, mnemonic, bip39Pass string, coinType, account,
 
 	return mnemonic, key, nil
 }
+
+// CreateMultisigKey stores a multisig key in the keyring with the provided name, built from the given public keys
+// and threshold. The public keys are sorted by address so that the resulting multisig address does not depend on
+// the order in which they are given.
+// Returns the created key record and any error encountered.
+func (c *Client) CreateMultisigKey(name string, pubKeys []types.PubKey, threshold int) (*keyring.Record, error) {
+	if threshold <= 0 || threshold > len(pubKeys) {
+		return nil, fmt.Errorf("invalid threshold %d for %d public keys", threshold, len(pubKeys))
+	}
+
+	// Sort the public keys by address.
+	pubKeys = slices.Clone(pubKeys)
+	slices.SortFunc(pubKeys, func(a, b types.PubKey) int {
+		return bytes.Compare(a.Address(), b.Address())
+	})
+
+	// Create and store the multisig public key.
+	pubKey := multisig.NewLegacyAminoPubKey(threshold, pubKeys)
+
+	key, err := c.keyring.SaveMultisig(name, pubKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to save multisig key: %w", err)
+	}
+
+	return key, nil
+}
@@ -2,9 +2,13 @@ package client
 
 import (
 	"context"
+	"fmt"
 
+	core "github.com/cometbft/cometbft/rpc/core/types"
+	cosmossdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/sentinel-official/hub/v12/types"
+	v1base "github.com/sentinel-official/hub/v12/types/v1"
 	"github.com/sentinel-official/hub/v12/x/lease/types/v1"
 )
 
@@ -14,6 +18,7 @@ const (
 	methodQueryLeases            = "/sentinel.lease.v1.QueryService/QueryLeases"            // List leases with pagination
 	methodQueryLeasesForNode     = "/sentinel.lease.v1.QueryService/QueryLeasesForNode"     // List leases associated with a specific node
 	methodQueryLeasesForProvider = "/sentinel.lease.v1.QueryService/QueryLeasesForProvider" // List leases associated with a specific provider
+	methodQueryLeaseParams       = "/sentinel.lease.v1.QueryService/QueryParams"            // Retrieve the parameters of the lease module
 )
 
 // Lease retrieves details of a specific lease by ID.
@@ -48,6 +53,14 @@ func (c *Client) Leases(ctx context.Context, pageReq *query.PageRequest) (res []
 	return resp.Leases, resp.Pagination, nil
 }
 
+// AllLeases returns an iterator over all leases.
+// Pages are fetched on demand according to the given options.
+func (c *Client) AllLeases(ctx context.Context, opts PageOptions) func(yield func(v1.Lease, error) bool) {
+	return iteratePages(ctx, opts, func(ctx context.Context, pageReq *query.PageRequest) ([]v1.Lease, *query.PageResponse, error) {
+		return c.Leases(ctx, pageReq)
+	})
+}
+
 // LeasesForNode retrieves leases associated with a specific node address.
 // Returns the leases, pagination details, and any error encountered.
 func (c *Client) LeasesForNode(ctx context.Context, nodeAddr types.NodeAddress, pageReq *query.PageRequest) (res []v1.Lease, pageRes *query.PageResponse, err error) {
@@ -67,6 +80,14 @@ func (c *Client) LeasesForNode(ctx context.Context, nodeAddr types.NodeAddress,
 	return resp.Leases, resp.Pagination, nil
 }
 
+// AllLeasesForNode returns an iterator over all leases associated with a specific node address.
+// Pages are fetched on demand according to the given options.
+func (c *Client) AllLeasesForNode(ctx context.Context, nodeAddr types.NodeAddress, opts PageOptions) func(yield func(v1.Lease, error) bool) {
+	return iteratePages(ctx, opts, func(ctx context.Context, pageReq *query.PageRequest) ([]v1.Lease, *query.PageResponse, error) {
+		return c.LeasesForNode(ctx, nodeAddr, pageReq)
+	})
+}
+
 // LeasesForProvider retrieves leases associated with a specific provider address.
 // Returns the leases, pagination details, and any error encountered.
 func (c *Client) LeasesForProvider(ctx context.Context, provAddr types.ProvAddress, pageReq *query.PageRequest) (res []v1.Lease, pageRes *query.PageResponse, err error) {
@@ -85,3 +106,135 @@ func (c *Client) LeasesForProvider(ctx context.Context, provAddr types.ProvAddre
 
 	return resp.Leases, resp.Pagination, nil
 }
+
+// AllLeasesForProvider returns an iterator over all leases associated with a specific provider address.
+// Pages are fetched on demand according to the given options.
+func (c *Client) AllLeasesForProvider(ctx context.Context, provAddr types.ProvAddress, opts PageOptions) func(yield func(v1.Lease, error) bool) {
+	return iteratePages(ctx, opts, func(ctx context.Context, pageReq *query.PageRequest) ([]v1.Lease, *query.PageResponse, error) {
+		return c.LeasesForProvider(ctx, provAddr, pageReq)
+	})
+}
+
+// LeaseParams retrieves the parameters of the lease module.
+// Returns the module parameters and any error encountered.
+func (c *Client) LeaseParams(ctx context.Context) (*v1.Params, error) {
+	var (
+		resp v1.QueryParamsResponse
+		req  = &v1.QueryParamsRequest{}
+	)
+
+	// Perform the gRPC query to fetch the module parameters.
+	if err := c.QueryGRPC(ctx, methodQueryLeaseParams, req, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp.Params, nil
+}
+
+// validateNodeHourlyPrice checks that the node is active and that its hourly price in the denom of maxPrice
+// does not exceed the amount of maxPrice.
+// Returns an error if the node cannot be leased at the given price.
+func (c *Client) validateNodeHourlyPrice(ctx context.Context, nodeAddr types.NodeAddress, maxPrice cosmossdk.Coin) error {
+	// Fetch the node to read its current hourly prices.
+	node, err := c.Node(ctx, nodeAddr)
+	if err != nil {
+		return fmt.Errorf("failed to query node: %w", err)
+	}
+	if node == nil {
+		return fmt.Errorf("node %s does not exist", nodeAddr)
+	}
+	if !node.Status.Equal(v1base.StatusActive) {
+		return fmt.Errorf("node %s is not active", nodeAddr)
+	}
+
+	// Find the hourly price of the node in the requested denom.
+	price, found := node.HourlyPrice(maxPrice.Denom)
+	if !found {
+		return fmt.Errorf("node %s has no hourly price for denom %s", nodeAddr, maxPrice.Denom)
+	}
+
+	// Compare the quoted price against the maximum accepted price.
+	if price.QuoteValue.GT(maxPrice.Amount) {
+		return fmt.Errorf("hourly price %s of node %s exceeds the maximum price %s", price.QuotePrice(), nodeAddr, maxPrice)
+	}
+
+	return nil
+}
+
+// StartLease leases the given node for the specified number of hours on behalf of the provider owned by the signing account.
+// The hourly price of the node is checked against maxPrice before broadcasting, and the lease is paid in its denom.
+// Returns the broadcast result and any error encountered.
+func (c *Client) StartLease(ctx context.Context, nodeAddr types.NodeAddress, hours int64, maxPrice cosmossdk.Coin, renewalPricePolicy v1base.RenewalPricePolicy) (*core.ResultBroadcastTx, error) {
+	provAddr, err := c.ProvAddr()
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate the hourly price of the node.
+	if err := c.validateNodeHourlyPrice(ctx, nodeAddr, maxPrice); err != nil {
+		return nil, err
+	}
+
+	// Build the message and broadcast it.
+	msg := v1.NewMsgStartLeaseRequest(provAddr, nodeAddr, hours, maxPrice.Denom, renewalPricePolicy)
+	return c.BroadcastMsgs(ctx, msg)
+}
+
+// RenewLease renews the lease with the given ID for the specified number of hours.
+// The current hourly price of the leased node is checked against maxPrice before broadcasting.
+// Returns the broadcast result and any error encountered.
+func (c *Client) RenewLease(ctx context.Context, id uint64, hours int64, maxPrice cosmossdk.Coin) (*core.ResultBroadcastTx, error) {
+	provAddr, err := c.ProvAddr()
+	if err != nil {
+		return nil, err
+	}
+
+	// Fetch the lease to find the leased node.
+	lease, err := c.Lease(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query lease: %w", err)
+	}
+	if lease == nil {
+		return nil, fmt.Errorf("lease %d does not exist", id)
+	}
+
+	nodeAddr, err := types.NodeAddressFromBech32(lease.NodeAddress)
+	if err != nil {
+		return nil, fmt.Errorf("invalid node addr: %w", err)
+	}
+
+	// Validate the hourly price of the node.
+	if err := c.validateNodeHourlyPrice(ctx, nodeAddr, maxPrice); err != nil {
+		return nil, err
+	}
+
+	// Build the message and broadcast it.
+	msg := v1.NewMsgRenewLeaseRequest(provAddr, id, hours, maxPrice.Denom)
+	return c.BroadcastMsgs(ctx, msg)
+}
+
+// UpdateLease updates the renewal price policy of the lease with the given ID.
+// Returns the broadcast result and any error encountered.
+func (c *Client) UpdateLease(ctx context.Context, id uint64, renewalPricePolicy v1base.RenewalPricePolicy) (*core.ResultBroadcastTx, error) {
+	provAddr, err := c.ProvAddr()
+	if err != nil {
+		return nil, err
+	}
+
+	// Build the message and broadcast it.
+	msg := v1.NewMsgUpdateLeaseRequest(provAddr, id, renewalPricePolicy)
+	return c.BroadcastMsgs(ctx, msg)
+}
+
+// EndLease ends the lease with the given ID and refunds the remaining deposit to the provider.
+// Returns the broadcast result and any error encountered.
+func (c *Client) EndLease(ctx context.Context, id uint64) (*core.ResultBroadcastTx, error) {
+	provAddr, err := c.ProvAddr()
+	if err != nil {
+		return nil, err
+	}
+
+	// Build the message and broadcast it.
+	msg := v1.NewMsgEndLeaseRequest(provAddr, id)
+	return c.BroadcastMsgs(ctx, msg)
+}
@@ -0,0 +1,168 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	dbm "github.com/cometbft/cometbft-db"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/crypto/merkle"
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cometbft/cometbft/light"
+	lightdb "github.com/cometbft/cometbft/light/store/db"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	vpntypes "github.com/sentinel-official/hub/v12/x/vpn/types"
+)
+
+// NewLightClient creates a CometBFT light client which keeps a trusted header of the chain with the given ID,
+// starting from the trusted height and hash of the trust options. The first RPC address is used as the primary
+// and the remaining ones as witnesses; with a single address, the primary also serves as its own witness.
+// Trusted headers are kept in memory.
+// Returns the light client or an error if the trusted header cannot be verified.
+func NewLightClient(ctx context.Context, chainID string, trustOpts light.TrustOptions, rpcAddrs ...string) (*light.Client, error) {
+	if len(rpcAddrs) == 0 {
+		return nil, errors.New("no rpc addrs given")
+	}
+
+	primaryAddr, witnessAddrs := rpcAddrs[0], rpcAddrs[1:]
+	if len(witnessAddrs) == 0 {
+		witnessAddrs = []string{primaryAddr}
+	}
+
+	store := lightdb.New(dbm.NewMemDB(), chainID)
+
+	lc, err := light.NewHTTPClient(ctx, chainID, trustOpts, primaryAddr, witnessAddrs, store, light.Logger(log.NewNopLogger()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create light client: %w", err)
+	}
+
+	return lc, nil
+}
+
+// WithLightClient sets the light client used to verify the results of ABCI queries and returns the updated Client.
+// Once set, every ABCI query requests a proof and its result is rejected unless the proof can be verified against
+// the app hash of a header trusted by the light client. Only store key queries carry proofs, so Account, Node and
+// Session read the store keys of the chain instead of querying through gRPC. Other gRPC queries through the ABCI
+// querier, such as the simulation of transactions, fail in this mode unless allowed through
+// WithQueryAllowUnverified, while those through other queriers are not verified.
+func (c *Client) WithLightClient(lc *light.Client) *Client {
+	c.queryLightClient = lc
+	return c
+}
+
+// WithQueryAllowUnverified sets whether the queries which cannot be verified by the light client, such as the
+// simulation of transactions and the listing queries, are performed without verification instead of failing,
+// and returns the updated Client. Store key queries are always verified once a light client is set.
+func (c *Client) WithQueryAllowUnverified(allow bool) *Client {
+	c.queryAllowUnverified = allow
+	return c
+}
+
+// verifiedQueryHeight returns the height at which verified queries are performed when no query height is set.
+// The app hash of a block is only included in the header of the next block, so the height just below the latest
+// trusted header is used.
+func (c *Client) verifiedQueryHeight(ctx context.Context) (int64, error) {
+	// Advance the light client to the latest header, if a newer one is available.
+	lb, err := c.queryLightClient.Update(ctx, time.Now())
+	if err != nil {
+		return 0, fmt.Errorf("failed to update light client: %w", err)
+	}
+	if lb == nil {
+		lb, err = c.queryLightClient.TrustedLightBlock(0)
+		if err != nil {
+			return 0, fmt.Errorf("failed to get trusted light block: %w", err)
+		}
+	}
+
+	return lb.Height - 1, nil
+}
+
+// storeKeyPath builds the Merkle key path of the given key for a store key query path of the form /store/<name>/key.
+// Returns an error for any other query path, as only store key queries return proofs.
+func storeKeyPath(path string, key []byte) (merkle.KeyPath, error) {
+	parts := strings.Split(strings.TrimPrefix(path, "/"), "/")
+	if len(parts) != 3 || parts[0] != "store" || parts[2] != "key" {
+		return nil, fmt.Errorf("query path %s cannot be verified", path)
+	}
+
+	return merkle.KeyPath{}.
+		AppendKey([]byte(parts[1]), merkle.KeyEncodingURL).
+		AppendKey(key, merkle.KeyEncodingURL), nil
+}
+
+// vpnStoreKey returns the key of the given module key in the store of the vpn module, which holds the state of
+// the node, session, subscription and plan modules under a prefix named after each module.
+func vpnStoreKey(module string, key []byte) []byte {
+	return append([]byte(module+"/"), key...)
+}
+
+// queryVerifiedStoreKey performs a verified query for the given key of the store with the given name.
+// Returns the value of the key, nil if it does not exist, or an error.
+func (c *Client) queryVerifiedStoreKey(ctx context.Context, store string, key []byte) ([]byte, error) {
+	resp, err := c.QueryKey(ctx, store, key)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Value, nil
+}
+
+// queryVerifiedKey performs a verified query for the given key of a module in the store of the vpn module.
+// Returns the value of the key, nil if it does not exist, or an error.
+func (c *Client) queryVerifiedKey(ctx context.Context, module string, key []byte) ([]byte, error) {
+	return c.queryVerifiedStoreKey(ctx, vpntypes.StoreKey, vpnStoreKey(module, key))
+}
+
+// verifyQueryResponse verifies the proof of an ABCI query response for the given key at the given height against
+// the app hash of the header trusted by the light client at the next height.
+// Returns an error if the response is not for the requested key and height, does not carry a proof, or the proof
+// does not match.
+func (c *Client) verifyQueryResponse(ctx context.Context, path string, key []byte, height int64, resp *abci.ResponseQuery) error {
+	if resp.IsErr() {
+		return &ABCIError{
+			Codespace: resp.Codespace,
+			Code:      resp.Code,
+			Log:       resp.Log,
+		}
+	}
+
+	// A valid proof for another key or height proves nothing about the requested one.
+	if !bytes.Equal(resp.Key, key) {
+		return fmt.Errorf("response key %X does not match requested key %X", resp.Key, key)
+	}
+	if resp.Height != height {
+		return fmt.Errorf("response height %d does not match requested height %d", resp.Height, height)
+	}
+	if resp.ProofOps == nil || len(resp.ProofOps.Ops) == 0 {
+		return errors.New("response has no proof")
+	}
+
+	kp, err := storeKeyPath(path, key)
+	if err != nil {
+		return err
+	}
+
+	// The app hash of the queried height is included in the header of the next height.
+	lb, err := c.queryLightClient.VerifyLightBlockAtHeight(ctx, resp.Height+1, time.Now())
+	if err != nil {
+		return fmt.Errorf("failed to verify header at height %d: %w", resp.Height+1, err)
+	}
+
+	// Verify the value, or its absence, against the trusted app hash.
+	prt := rootmulti.DefaultProofRuntime()
+	if len(resp.Value) > 0 {
+		if err := prt.VerifyValue(resp.ProofOps, lb.AppHash, kp.String(), resp.Value); err != nil {
+			return fmt.Errorf("failed to verify value proof: %w", err)
+		}
+	} else {
+		if err := prt.VerifyAbsence(resp.ProofOps, lb.AppHash, kp.String()); err != nil {
+			return fmt.Errorf("failed to verify absence proof: %w", err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,240 @@
+package client_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	cosmossdk "github.com/cosmos/cosmos-sdk/types"
+	sentinelhub "github.com/sentinel-official/hub/v12/types"
+	v1base "github.com/sentinel-official/hub/v12/types/v1"
+	nodekeys "github.com/sentinel-official/hub/v12/x/node/types"
+	nodetypes "github.com/sentinel-official/hub/v12/x/node/types/v3"
+	sessiontypes "github.com/sentinel-official/hub/v12/x/session/types/v3"
+	subscriptiontypes "github.com/sentinel-official/hub/v12/x/subscription/types/v3"
+
+	"github.com/sentinel-official/sentinel-go-sdk/client"
+	"github.com/sentinel-official/sentinel-go-sdk/client/clienttest"
+)
+
+// newVerifiedTestClient creates a client of the given chain whose queries are verified by a light client
+// trusting the first block of the chain, sending the queries to rpcAddr.
+func newVerifiedTestClient(t *testing.T, ch *clienttest.Chain, rpcAddr string) *client.Client {
+	t.Helper()
+
+	lc, err := client.NewLightClient(context.Background(), ch.ChainID(), ch.TrustOptions(time.Hour), ch.URL())
+	if err != nil {
+		t.Fatalf("failed to create light client: %v", err)
+	}
+
+	c, _ := newTestClient(t, ch, "alice")
+	return c.WithRPCAddr(rpcAddr).WithLightClient(lc)
+}
+
+// newTestNode returns an active node with the given address and an hourly price.
+func newTestNode(nodeAddr sentinelhub.NodeAddress) nodetypes.Node {
+	return nodetypes.Node{
+		Address: nodeAddr.String(),
+		HourlyPrices: []v1base.Price{
+			{Denom: "udvpn", BaseValue: math.LegacyNewDec(1), QuoteValue: math.NewInt(1000)},
+		},
+		RemoteURL: "https://node.example:8080",
+		Status:    v1base.StatusActive,
+	}
+}
+
+// newTestNodeAddr returns a node address made of the given byte.
+func newTestNodeAddr(b byte) sentinelhub.NodeAddress {
+	return bytes.Repeat([]byte{b}, 20)
+}
+
+func TestVerifiedQueries(t *testing.T) {
+	ch := newTestChain(t)
+
+	nodeAddr := newTestNodeAddr(1)
+	ch.SetNode(newTestNode(nodeAddr))
+	ch.SetSession(&subscriptiontypes.Session{
+		BaseSession: &sessiontypes.BaseSession{
+			ID:            7,
+			AccAddress:    cosmossdk.AccAddress(bytes.Repeat([]byte{2}, 20)).String(),
+			NodeAddress:   nodeAddr.String(),
+			DownloadBytes: math.NewInt(100),
+			UploadBytes:   math.NewInt(50),
+			MaxBytes:      math.NewInt(1000),
+			Status:        v1base.StatusActive,
+		},
+		SubscriptionID: 3,
+	})
+
+	// The state is committed by the next block and covered by the app hash of the one after it.
+	ch.NextBlock()
+	ch.NextBlock()
+
+	c := newVerifiedTestClient(t, ch, ch.URL())
+	ctx := context.Background()
+
+	node, err := c.Node(ctx, nodeAddr)
+	if err != nil {
+		t.Fatalf("Node: %v", err)
+	}
+	if node == nil || node.Address != nodeAddr.String() || !node.HourlyPrices[0].QuoteValue.Equal(math.NewInt(1000)) {
+		t.Fatalf("Node = %v, want the node with its hourly price", node)
+	}
+
+	node, err = c.Node(ctx, newTestNodeAddr(9))
+	if err != nil {
+		t.Fatalf("Node of missing node: %v", err)
+	}
+	if node != nil {
+		t.Fatalf("Node of missing node = %v, want nil", node)
+	}
+
+	session, err := c.Session(ctx, 7)
+	if err != nil {
+		t.Fatalf("Session: %v", err)
+	}
+	if session == nil || session.GetID() != 7 || session.GetNodeAddress() != nodeAddr.String() {
+		t.Fatalf("Session = %v, want session 7", session)
+	}
+
+	// Queries without proofs are rejected, unless unverified queries are allowed.
+	if _, _, err := c.Nodes(ctx, v1base.StatusActive, nil); err == nil {
+		t.Fatal("Nodes succeeded, want an error as gRPC queries cannot be verified")
+	}
+
+	nodes, _, err := c.WithQueryAllowUnverified(true).Nodes(ctx, v1base.StatusActive, nil)
+	if err != nil {
+		t.Fatalf("Nodes with unverified queries allowed: %v", err)
+	}
+	if len(nodes) != 1 {
+		t.Fatalf("Nodes = %v, want the single node", nodes)
+	}
+}
+
+// newTamperingProxy starts a JSON-RPC proxy to the given address which rewrites the parameters of ABCI queries
+// with rewrite before forwarding them.
+func newTamperingProxy(t *testing.T, addr string, rewrite func(params map[string]any)) *httptest.Server {
+	t.Helper()
+
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req map[string]any
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if params, ok := req["params"].(map[string]any); ok && req["method"] == "abci_query" {
+			rewrite(params)
+		}
+
+		body, err := json.Marshal(req)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		resp, err := http.Post(addr, "application/json", bytes.NewReader(body))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadGateway)
+			return
+		}
+		defer resp.Body.Close()
+
+		w.Header().Set("Content-Type", resp.Header.Get("Content-Type"))
+		w.WriteHeader(resp.StatusCode)
+		_, _ = io.Copy(w, resp.Body)
+	}))
+	t.Cleanup(proxy.Close)
+
+	return proxy
+}
+
+func TestVerifiedQueriesRejectTamperedResponses(t *testing.T) {
+	ch := newTestChain(t)
+	ch.NextBlock()
+
+	// The queried node only exists from the third block, while another node is always present.
+	otherAddr := newTestNodeAddr(2)
+	ch.SetNode(newTestNode(otherAddr))
+	ch.NextBlock()
+
+	nodeAddr := newTestNodeAddr(1)
+	ch.SetNode(newTestNode(nodeAddr))
+	ch.NextBlock()
+	ch.NextBlock()
+
+	otherKey := append([]byte(nodekeys.ModuleName+"/"), nodekeys.ActiveNodeKey(otherAddr)...)
+
+	tests := []struct {
+		name    string
+		rewrite func(params map[string]any)
+	}{
+		{
+			name: "proof for another key",
+			rewrite: func(params map[string]any) {
+				params["data"] = hex.EncodeToString(otherKey)
+			},
+		},
+		{
+			name: "proof at another height",
+			rewrite: func(params map[string]any) {
+				params["height"] = "2"
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			proxy := newTamperingProxy(t, ch.URL(), tt.rewrite)
+			c := newVerifiedTestClient(t, ch, proxy.URL)
+
+			node, err := c.Node(context.Background(), nodeAddr)
+			if err == nil {
+				t.Fatalf("Node = %v, want an error for the tampered response", node)
+			}
+		})
+	}
+}
+
+func TestVerifiedBroadcastTx(t *testing.T) {
+	ch := newTestChain(t)
+	c := newVerifiedTestClient(t, ch, ch.URL())
+
+	// The account created for the client is committed by the next block and covered by the one after it.
+	ch.NextBlock()
+	ch.NextBlock()
+
+	accAddr, err := c.FromAddr()
+	if err != nil {
+		t.Fatalf("FromAddr: %v", err)
+	}
+
+	// With a fixed gas limit, signing only reads the account, whose store key is verified.
+	ctx := context.Background()
+	if _, err := c.BroadcastTx(ctx, newTestMsgs(accAddr)); err != nil {
+		t.Fatalf("BroadcastTx: %v", err)
+	}
+
+	// Simulations cannot be verified, so they fail unless unverified queries are allowed.
+	c.WithTxGas(0).
+		WithTxGasAdjustment(1.5).
+		WithTxSimulateAndExecute(true)
+
+	if _, err := c.BroadcastTx(ctx, newTestMsgs(accAddr)); err == nil {
+		t.Fatal("BroadcastTx succeeded, want an error as simulations cannot be verified")
+	}
+
+	c.WithQueryAllowUnverified(true)
+	if _, err := c.BroadcastTx(ctx, newTestMsgs(accAddr)); err != nil {
+		t.Fatalf("BroadcastTx with unverified simulation: %v", err)
+	}
+	if n := len(ch.BroadcastMsgs()); n != 2 {
+		t.Fatalf("chain received %d msgs, want 2", n)
+	}
+}
@@ -0,0 +1,135 @@
+package client
+
+import (
+	"fmt"
+
+	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
+	txsigning "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+// Multisig members sign the legacy amino JSON encoding, as the direct encoding of a transaction depends on the
+// set of signers, which is unknown until all partial signatures are combined.
+const multisigSignMode = txsigning.SignMode_SIGN_MODE_LEGACY_AMINO_JSON
+
+// SignMultisigTx produces the partial signature of the key with the given name over an unsigned transaction
+// of a multisig account. The account number and sequence of the unsigned transaction must be those of the
+// multisig account.
+// Returns the JSON encoded partial signature or an error if decoding or signing fails.
+func (c *Client) SignMultisigTx(name string, unsignedTx *UnsignedTx) ([]byte, error) {
+	txb, signerData, err := c.decodeUnsignedTx(unsignedTx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get the bytes to be signed.
+	buf, err := c.txConfig.SignModeHandler().GetSignBytes(multisigSignMode, signerData, txb.GetTx())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tx sign bytes: %w", err)
+	}
+
+	// Sign the transaction bytes.
+	buf, pubKey, err := c.Sign(name, buf)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign tx bytes: %w", err)
+	}
+
+	// Encode the partial signature as JSON.
+	signature := txsigning.SignatureV2{
+		PubKey: pubKey,
+		Data: &txsigning.SingleSignatureData{
+			SignMode:  multisigSignMode,
+			Signature: buf,
+		},
+		Sequence: signerData.Sequence,
+	}
+
+	buf, err = c.txConfig.MarshalSignatureJSON([]txsigning.SignatureV2{signature})
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode signature: %w", err)
+	}
+
+	return buf, nil
+}
+
+// CombineMultisigTx combines the partial signatures of the members of the multisig key with the given name into
+// a signed transaction. Each partial signature is verified against the unsigned transaction before it is added.
+// Returns the JSON encoded signed transaction or an error if fewer signatures than the threshold are valid.
+func (c *Client) CombineMultisigTx(name string, unsignedTx *UnsignedTx, partialSigs [][]byte) ([]byte, error) {
+	// Retrieve the multisig public key.
+	key, err := c.Key(name)
+	if err != nil {
+		return nil, err
+	}
+
+	pubKey, err := key.GetPubKey()
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve public key: %w", err)
+	}
+
+	multisigPubKey, ok := pubKey.(*kmultisig.LegacyAminoPubKey)
+	if !ok {
+		return nil, fmt.Errorf("key %s is not a multisig key", name)
+	}
+
+	txb, signerData, err := c.decodeUnsignedTx(unsignedTx)
+	if err != nil {
+		return nil, err
+	}
+
+	accAddr, err := key.GetAddress()
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve addr: %w", err)
+	}
+
+	signerData.Address = accAddr.String()
+	signerData.PubKey = pubKey
+
+	// Verify and add each partial signature to the multisig signature.
+	var (
+		signers     = make(map[string]bool)
+		multisigSig = multisig.NewMultisig(len(multisigPubKey.PubKeys))
+	)
+
+	for _, buf := range partialSigs {
+		sigs, err := c.txConfig.UnmarshalSignatureJSON(buf)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode signature: %w", err)
+		}
+
+		for _, sig := range sigs {
+			if err := authsigning.VerifySignature(sig.PubKey, signerData, sig.Data, c.txConfig.SignModeHandler(), txb.GetTx()); err != nil {
+				return nil, fmt.Errorf("invalid signature from %s: %w", sig.PubKey.Address(), err)
+			}
+			if err := multisig.AddSignatureV2(multisigSig, sig, multisigPubKey.GetPubKeys()); err != nil {
+				return nil, fmt.Errorf("failed to add signature from %s: %w", sig.PubKey.Address(), err)
+			}
+
+			signers[sig.PubKey.Address().String()] = true
+		}
+	}
+
+	if len(signers) < int(multisigPubKey.Threshold) {
+		return nil, fmt.Errorf("got %d signatures, threshold is %d", len(signers), multisigPubKey.Threshold)
+	}
+
+	// Set the combined signature in the transaction builder.
+	signature := txsigning.SignatureV2{
+		PubKey:   multisigPubKey,
+		Data:     multisigSig,
+		Sequence: signerData.Sequence,
+	}
+
+	if err := txb.SetSignatures(signature); err != nil {
+		return nil, fmt.Errorf("failed to set signatures: %w", err)
+	}
+
+	// Encode the signed transaction as JSON.
+	buf, err := c.txConfig.TxJSONEncoder()(txb.GetTx())
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode tx: %w", err)
+	}
+
+	return buf, nil
+}
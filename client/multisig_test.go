@@ -0,0 +1,136 @@
+package client_test
+
+import (
+	"context"
+	"testing"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	cosmossdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sentinel-official/sentinel-go-sdk/client"
+	"github.com/sentinel-official/sentinel-go-sdk/client/clienttest"
+)
+
+// testMultisigMembers are the names of the member keys of the test multisig key.
+var testMultisigMembers = []string{"bob", "carol", "dave"}
+
+// newTestMultisigKey creates the member keys and a 2-of-3 multisig key with the given name from them, and adds the
+// multisig account to the chain.
+// Returns the address of the multisig account.
+func newTestMultisigKey(t *testing.T, ch *clienttest.Chain, c *client.Client, name string) cosmossdk.AccAddress {
+	t.Helper()
+
+	pubKeys := make([]cryptotypes.PubKey, len(testMultisigMembers))
+	for i, member := range testMultisigMembers {
+		_, key, err := c.CreateKey(member, "", "", 118, 0, 0)
+		if err != nil {
+			t.Fatalf("CreateKey(%s): %v", member, err)
+		}
+
+		pubKeys[i], err = key.GetPubKey()
+		if err != nil {
+			t.Fatalf("failed to get public key of %s: %v", member, err)
+		}
+	}
+
+	key, err := c.CreateMultisigKey(name, pubKeys, 2)
+	if err != nil {
+		t.Fatalf("CreateMultisigKey: %v", err)
+	}
+
+	accAddr, err := key.GetAddress()
+	if err != nil {
+		t.Fatalf("failed to get multisig address: %v", err)
+	}
+
+	ch.AddAccount(accAddr)
+	return accAddr
+}
+
+// signTestMultisigTx collects the partial signatures of the given members over an unsigned transaction.
+func signTestMultisigTx(t *testing.T, c *client.Client, unsignedTx *client.UnsignedTx, members ...string) [][]byte {
+	t.Helper()
+
+	partialSigs := make([][]byte, len(members))
+	for i, member := range members {
+		var err error
+
+		partialSigs[i], err = c.SignMultisigTx(member, unsignedTx)
+		if err != nil {
+			t.Fatalf("SignMultisigTx(%s): %v", member, err)
+		}
+	}
+
+	return partialSigs
+}
+
+func TestCombineMultisigTxAndBroadcast(t *testing.T) {
+	ch := newTestChain(t)
+	c, _ := newTestClient(t, ch, "alice")
+	ctx := context.Background()
+
+	accAddr := newTestMultisigKey(t, ch, c, "multisig")
+	unsignedTx := newTestUnsignedTx(t, c, accAddr)
+
+	// Two of the three members sign, which meets the threshold.
+	partialSigs := signTestMultisigTx(t, c, unsignedTx, "bob", "dave")
+
+	signedTx, err := c.CombineMultisigTx("multisig", unsignedTx, partialSigs)
+	if err != nil {
+		t.Fatalf("CombineMultisigTx: %v", err)
+	}
+
+	res, err := c.BroadcastSignedTx(ctx, signedTx)
+	if err != nil {
+		t.Fatalf("BroadcastSignedTx: %v", err)
+	}
+	if _, err := c.WaitForTx(ctx, res.Hash); err != nil {
+		t.Fatalf("WaitForTx: %v", err)
+	}
+	if n := len(ch.BroadcastMsgs()); n != 1 {
+		t.Fatalf("chain received %d msgs, want 1", n)
+	}
+}
+
+func TestCombineMultisigTxBelowThreshold(t *testing.T) {
+	ch := newTestChain(t)
+	c, _ := newTestClient(t, ch, "alice")
+
+	accAddr := newTestMultisigKey(t, ch, c, "multisig")
+	unsignedTx := newTestUnsignedTx(t, c, accAddr)
+
+	// A single member signs, below the threshold of two.
+	partialSigs := signTestMultisigTx(t, c, unsignedTx, "carol")
+	if _, err := c.CombineMultisigTx("multisig", unsignedTx, partialSigs); err == nil {
+		t.Fatal("CombineMultisigTx succeeded with fewer signatures than the threshold")
+	}
+
+	// The same member signing twice does not meet the threshold either.
+	partialSigs = signTestMultisigTx(t, c, unsignedTx, "carol", "carol")
+	if _, err := c.CombineMultisigTx("multisig", unsignedTx, partialSigs); err == nil {
+		t.Fatal("CombineMultisigTx succeeded with a duplicate signature")
+	}
+}
+
+func TestCombineMultisigTxRejectsOtherTxSignature(t *testing.T) {
+	ch := newTestChain(t)
+	c, _ := newTestClient(t, ch, "alice")
+
+	accAddr := newTestMultisigKey(t, ch, c, "multisig")
+	unsignedTx := newTestUnsignedTx(t, c, accAddr)
+
+	// One member signs a different transaction of the same account, with the next sequence.
+	otherTx, err := c.GenerateTx(newTestMsgs(accAddr), unsignedTx.AccountNumber, unsignedTx.Sequence+1)
+	if err != nil {
+		t.Fatalf("GenerateTx: %v", err)
+	}
+
+	partialSigs := append(
+		signTestMultisigTx(t, c, unsignedTx, "bob"),
+		signTestMultisigTx(t, c, otherTx, "carol")...,
+	)
+
+	if _, err := c.CombineMultisigTx("multisig", unsignedTx, partialSigs); err == nil {
+		t.Fatal("CombineMultisigTx succeeded with a signature over a different tx")
+	}
+}
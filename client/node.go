@@ -2,10 +2,13 @@ package client
 
 import (
 	"context"
+	"fmt"
 
+	core "github.com/cometbft/cometbft/rpc/core/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/sentinel-official/hub/v12/types"
 	"github.com/sentinel-official/hub/v12/types/v1"
+	nodetypes "github.com/sentinel-official/hub/v12/x/node/types"
 	"github.com/sentinel-official/hub/v12/x/node/types/v3"
 )
 
@@ -14,11 +17,17 @@ const (
 	methodQueryNode         = "/sentinel.node.v3.QueryService/QueryNode"         // Retrieve details of a specific node
 	methodQueryNodes        = "/sentinel.node.v3.QueryService/QueryNodes"        // Retrieve a list of nodes with optional filtering
 	methodQueryNodesForPlan = "/sentinel.node.v3.QueryService/QueryNodesForPlan" // Retrieve nodes associated with a specific plan
+	methodQueryNodeParams   = "/sentinel.node.v3.QueryService/QueryParams"       // Retrieve the parameters of the node module
 )
 
 // Node retrieves details of a specific node by its address.
+// When a light client is set, the node is read from its store keys so that it can be verified.
 // Returns the node details and any error encountered.
 func (c *Client) Node(ctx context.Context, nodeAddr types.NodeAddress) (res *v3.Node, err error) {
+	if c.queryLightClient != nil {
+		return c.verifiedNode(ctx, nodeAddr)
+	}
+
 	var (
 		resp v3.QueryNodeResponse
 		req  = &v3.QueryNodeRequest{Address: nodeAddr.String()}
@@ -32,6 +41,29 @@ func (c *Client) Node(ctx context.Context, nodeAddr types.NodeAddress) (res *v3.
 	return &resp.Node, nil
 }
 
+// verifiedNode retrieves details of a specific node by reading its active or inactive store key with a proof.
+// Returns the node details, nil if the node does not exist, and any error encountered.
+func (c *Client) verifiedNode(ctx context.Context, nodeAddr types.NodeAddress) (*v3.Node, error) {
+	for _, key := range [][]byte{nodetypes.ActiveNodeKey(nodeAddr), nodetypes.InactiveNodeKey(nodeAddr)} {
+		value, err := c.queryVerifiedKey(ctx, nodetypes.ModuleName, key)
+		if err != nil {
+			return nil, err
+		}
+		if value == nil {
+			continue
+		}
+
+		var node v3.Node
+		if err := c.protoCodec.Unmarshal(value, &node); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal node: %w", err)
+		}
+
+		return &node, nil
+	}
+
+	return nil, nil
+}
+
 // Nodes retrieves a paginated list of nodes filtered by their status.
 // Returns the nodes, pagination details, and any error encountered.
 func (c *Client) Nodes(ctx context.Context, status v1.Status, pageReq *query.PageRequest) (res []v3.Node, pageRes *query.PageResponse, err error) {
@@ -51,6 +83,14 @@ func (c *Client) Nodes(ctx context.Context, status v1.Status, pageReq *query.Pag
 	return resp.Nodes, resp.Pagination, nil
 }
 
+// AllNodes returns an iterator over all nodes filtered by their status.
+// Pages are fetched on demand according to the given options.
+func (c *Client) AllNodes(ctx context.Context, status v1.Status, opts PageOptions) func(yield func(v3.Node, error) bool) {
+	return iteratePages(ctx, opts, func(ctx context.Context, pageReq *query.PageRequest) ([]v3.Node, *query.PageResponse, error) {
+		return c.Nodes(ctx, status, pageReq)
+	})
+}
+
 // NodesForPlan retrieves a list of nodes associated with a specific plan ID.
 // Filters results by status and supports pagination.
 // Returns the nodes, pagination details, and any error encountered.
@@ -71,3 +111,80 @@ func (c *Client) NodesForPlan(ctx context.Context, id uint64, status v1.Status,
 
 	return resp.Nodes, resp.Pagination, nil
 }
+
+// AllNodesForPlan returns an iterator over all nodes associated with a specific plan ID, filtered by their status.
+// Pages are fetched on demand according to the given options.
+func (c *Client) AllNodesForPlan(ctx context.Context, id uint64, status v1.Status, opts PageOptions) func(yield func(v3.Node, error) bool) {
+	return iteratePages(ctx, opts, func(ctx context.Context, pageReq *query.PageRequest) ([]v3.Node, *query.PageResponse, error) {
+		return c.NodesForPlan(ctx, id, status, pageReq)
+	})
+}
+
+// NodeParams retrieves the parameters of the node module.
+// Returns the module parameters and any error encountered.
+func (c *Client) NodeParams(ctx context.Context) (*v3.Params, error) {
+	var (
+		resp v3.QueryParamsResponse
+		req  = &v3.QueryParamsRequest{}
+	)
+
+	// Perform the gRPC query to fetch the module parameters.
+	if err := c.QueryGRPC(ctx, methodQueryNodeParams, req, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp.Params, nil
+}
+
+// RegisterNode registers the signing account as a node with the given prices and remote URL.
+// Returns the broadcast result and any error encountered.
+func (c *Client) RegisterNode(ctx context.Context, gigabytePrices, hourlyPrices v1.Prices, remoteURL string) (*core.ResultBroadcastTx, error) {
+	accAddr, err := c.FromAddr()
+	if err != nil {
+		return nil, err
+	}
+
+	// Build the message and broadcast it.
+	msg := v3.NewMsgRegisterNodeRequest(accAddr, gigabytePrices, hourlyPrices, remoteURL)
+	return c.BroadcastMsgs(ctx, msg)
+}
+
+// UpdateNodeDetails updates the prices and remote URL of the node owned by the signing account.
+// An empty remote URL leaves the existing one unchanged.
+// Returns the broadcast result and any error encountered.
+func (c *Client) UpdateNodeDetails(ctx context.Context, gigabytePrices, hourlyPrices v1.Prices, remoteURL string) (*core.ResultBroadcastTx, error) {
+	accAddr, err := c.FromAddr()
+	if err != nil {
+		return nil, err
+	}
+
+	// Build the message and broadcast it.
+	msg := v3.NewMsgUpdateNodeDetailsRequest(accAddr.Bytes(), gigabytePrices, hourlyPrices, remoteURL)
+	return c.BroadcastMsgs(ctx, msg)
+}
+
+// UpdateNodeStatus updates the status of the node owned by the signing account.
+// Returns the broadcast result and any error encountered.
+func (c *Client) UpdateNodeStatus(ctx context.Context, status v1.Status) (*core.ResultBroadcastTx, error) {
+	accAddr, err := c.FromAddr()
+	if err != nil {
+		return nil, err
+	}
+
+	// Build the message and broadcast it.
+	msg := v3.NewMsgUpdateNodeStatusRequest(accAddr.Bytes(), status)
+	return c.BroadcastMsgs(ctx, msg)
+}
+
+// StartSessionOnNode starts a session on the given node, paying for either gigabytes or hours in the given denom.
+// Returns the broadcast result and any error encountered.
+func (c *Client) StartSessionOnNode(ctx context.Context, nodeAddr types.NodeAddress, gigabytes, hours int64, denom string) (*core.ResultBroadcastTx, error) {
+	accAddr, err := c.FromAddr()
+	if err != nil {
+		return nil, err
+	}
+
+	// Build the message and broadcast it.
+	msg := v3.NewMsgStartSessionRequest(accAddr, nodeAddr, gigabytes, hours, denom)
+	return c.BroadcastMsgs(ctx, msg)
+}
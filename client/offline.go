@@ -0,0 +1,170 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	core "github.com/cometbft/cometbft/rpc/core/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	cosmossdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+// UnsignedTx contains an unsigned transaction along with the signer data required to sign it offline.
+type UnsignedTx struct {
+	ChainID       string          `json:"chain_id"`       // Chain ID the transaction is signed for
+	AccountNumber uint64          `json:"account_number"` // Account number of the signer
+	Sequence      uint64          `json:"sequence"`       // Sequence of the signer
+	Tx            json.RawMessage `json:"tx"`             // JSON encoded unsigned transaction
+}
+
+// decodeUnsignedTx decodes the transaction of an unsigned transaction and wraps it in a builder.
+// Returns the transaction builder along with the signer data carried by the unsigned transaction.
+func (c *Client) decodeUnsignedTx(unsignedTx *UnsignedTx) (client.TxBuilder, authsigning.SignerData, error) {
+	signerData := authsigning.SignerData{
+		ChainID:       unsignedTx.ChainID,
+		AccountNumber: unsignedTx.AccountNumber,
+		Sequence:      unsignedTx.Sequence,
+	}
+
+	tx, err := c.txConfig.TxJSONDecoder()(unsignedTx.Tx)
+	if err != nil {
+		return nil, signerData, fmt.Errorf("failed to decode tx: %w", err)
+	}
+
+	txb, err := c.txConfig.WrapTxBuilder(tx)
+	if err != nil {
+		return nil, signerData, fmt.Errorf("failed to wrap tx: %w", err)
+	}
+
+	// Set the address of the signer, which the legacy amino JSON sign mode requires.
+	if signers := txb.GetTx().GetSigners(); len(signers) > 0 {
+		signerData.Address = signers[0].String()
+	}
+
+	return txb, signerData, nil
+}
+
+// DecodeMsgsJSON decodes the messages of a JSON encoded transaction body with the configured codec, so that any
+// message type registered with its interface registry can be decoded.
+// Returns the messages or an error if decoding or unpacking fails.
+func (c *Client) DecodeMsgsJSON(buf []byte) ([]cosmossdk.Msg, error) {
+	var body tx.TxBody
+	if err := c.protoCodec.UnmarshalJSON(buf, &body); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal tx body: %w", err)
+	}
+
+	// Unpack each message of the transaction body.
+	msgs := make([]cosmossdk.Msg, len(body.Messages))
+	for i := 0; i < len(body.Messages); i++ {
+		if err := c.protoCodec.UnpackAny(body.Messages[i], &msgs[i]); err != nil {
+			return nil, fmt.Errorf("failed to unpack message: %w", err)
+		}
+	}
+
+	return msgs, nil
+}
+
+// GenerateTx builds an unsigned transaction with the given messages and the configured fees, gas, memo,
+// fee granter and timeout height, without contacting the chain.
+// The account number and sequence of the signer must be given explicitly, and gas is not simulated.
+// Returns the unsigned transaction or an error if validation or encoding fails.
+func (c *Client) GenerateTx(msgs []cosmossdk.Msg, accountNumber, sequence uint64) (*UnsignedTx, error) {
+	// Perform the stateless validation of each message.
+	for _, msg := range msgs {
+		if err := msg.ValidateBasic(); err != nil {
+			return nil, fmt.Errorf("invalid message %T: %w", msg, err)
+		}
+	}
+
+	// Build the transaction with the configured parameters.
+	txb, err := c.newTxBuilder(c.txFeeGranterAddr, msgs)
+	if err != nil {
+		return nil, err
+	}
+
+	// Encode the unsigned transaction as JSON.
+	buf, err := c.txConfig.TxJSONEncoder()(txb.GetTx())
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode tx: %w", err)
+	}
+
+	return &UnsignedTx{
+		ChainID:       c.chainID,
+		AccountNumber: accountNumber,
+		Sequence:      sequence,
+		Tx:            buf,
+	}, nil
+}
+
+// SignTx signs an unsigned transaction with the key of the given name using the signer data it carries.
+// No network access is required, so this can run on an air-gapped machine.
+// Returns the JSON encoded signed transaction or an error if decoding or signing fails.
+func (c *Client) SignTx(name string, unsignedTx *UnsignedTx) ([]byte, error) {
+	// Retrieve the signing key.
+	key, err := c.Key(name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve key: %w", err)
+	}
+
+	// Decode the unsigned transaction and sign it with the signer data it carries.
+	txb, signerData, err := c.decodeUnsignedTx(unsignedTx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := c.signTx(txb, key, signerData); err != nil {
+		return nil, fmt.Errorf("failed to sign tx: %w", err)
+	}
+
+	// Encode the signed transaction as JSON.
+	buf, err := c.txConfig.TxJSONEncoder()(txb.GetTx())
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode tx: %w", err)
+	}
+
+	return buf, nil
+}
+
+// BroadcastSignedTx broadcasts a JSON encoded signed transaction synchronously.
+// Broadcasts failing because the mempool is full or the request timed out are retried based on the retry policy.
+// Returns the broadcast result or an error if decoding or broadcasting fails. If the transaction is rejected
+// during CheckTx, the broadcast result is returned along with a TxError.
+func (c *Client) BroadcastSignedTx(ctx context.Context, buf []byte) (*core.ResultBroadcastTx, error) {
+	// Decode the signed transaction and encode it for broadcasting.
+	tx, err := c.txConfig.TxJSONDecoder()(buf)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode tx: %w", err)
+	}
+
+	buf, err = c.txConfig.TxEncoder()(tx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode tx: %w", err)
+	}
+
+	var res *core.ResultBroadcastTx
+
+	// Define the function to broadcast the signed transaction synchronously.
+	broadcastFunc := func() (err error) {
+		res, err = c.broadcastTxSync(ctx, buf)
+		if err != nil {
+			return fmt.Errorf("failed to sync broadcast tx: %w", err)
+		}
+
+		return checkTxError(res)
+	}
+
+	if err := c.retryBroadcast(ctx, broadcastFunc); err != nil {
+		var txErr *TxError
+		if errors.As(err, &txErr) {
+			return res, err
+		}
+
+		return nil, err
+	}
+
+	return res, nil
+}
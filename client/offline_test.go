@@ -0,0 +1,75 @@
+package client_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	cosmossdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/sentinel-official/sentinel-go-sdk/client"
+)
+
+// newTestUnsignedTx generates an unsigned transaction of the test messages from the given account, using its
+// current account number and sequence on the chain.
+func newTestUnsignedTx(t *testing.T, c *client.Client, accAddr cosmossdk.AccAddress) *client.UnsignedTx {
+	t.Helper()
+
+	account, err := c.Account(context.Background(), accAddr)
+	if err != nil {
+		t.Fatalf("Account: %v", err)
+	}
+
+	unsignedTx, err := c.GenerateTx(newTestMsgs(accAddr), account.GetAccountNumber(), account.GetSequence())
+	if err != nil {
+		t.Fatalf("GenerateTx: %v", err)
+	}
+
+	return unsignedTx
+}
+
+func TestSignTxAndBroadcastSignedTx(t *testing.T) {
+	ch := newTestChain(t)
+	c, accAddr := newTestClient(t, ch, "alice")
+	ctx := context.Background()
+
+	unsignedTx := newTestUnsignedTx(t, c, accAddr)
+
+	signedTx, err := c.SignTx("alice", unsignedTx)
+	if err != nil {
+		t.Fatalf("SignTx: %v", err)
+	}
+
+	res, err := c.BroadcastSignedTx(ctx, signedTx)
+	if err != nil {
+		t.Fatalf("BroadcastSignedTx: %v", err)
+	}
+	if n := len(ch.BroadcastMsgs()); n != 1 {
+		t.Fatalf("chain received %d msgs, want 1", n)
+	}
+
+	// The transaction is included and the sequence of the signer advanced.
+	if _, err := c.WaitForTx(ctx, res.Hash); err != nil {
+		t.Fatalf("WaitForTx: %v", err)
+	}
+
+	account, err := c.Account(ctx, accAddr)
+	if err != nil {
+		t.Fatalf("Account: %v", err)
+	}
+	if account.GetSequence() != unsignedTx.Sequence+1 {
+		t.Fatalf("sequence = %d, want %d", account.GetSequence(), unsignedTx.Sequence+1)
+	}
+
+	// Resending the signed transaction reports the same transaction without including it again.
+	resent, err := c.BroadcastSignedTx(ctx, signedTx)
+	if err != nil {
+		t.Fatalf("BroadcastSignedTx: %v", err)
+	}
+	if !bytes.Equal(resent.Hash, res.Hash) {
+		t.Fatalf("resent hash = %s, want %s", resent.Hash, res.Hash)
+	}
+	if n := len(ch.BroadcastMsgs()); n != 1 {
+		t.Fatalf("chain received %d msgs, want 1", n)
+	}
+}
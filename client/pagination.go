@@ -0,0 +1,132 @@
+package client
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+// PageOptions configures the iteration over the pages of a list query.
+type PageOptions struct {
+	Limit    uint64 // Number of items fetched per page, zero for the server default
+	MaxItems uint64 // Maximum number of items yielded, zero for no limit
+}
+
+// pageFunc fetches a single page of items for the given page request.
+type pageFunc[T any] func(ctx context.Context, pageReq *query.PageRequest) ([]T, *query.PageResponse, error)
+
+// iteratePages returns an iterator over the items of all pages returned by the given fetch function.
+// The next page is requested using the NextKey of the previous page until no more pages remain,
+// the maximum number of items is reached, or the context is done.
+// The iterator has the shape of iter.Seq2 and yields a non-nil error as its final element if a query fails.
+func iteratePages[T any](ctx context.Context, opts PageOptions, fetch pageFunc[T]) func(yield func(T, error) bool) {
+	return func(yield func(T, error) bool) {
+		var (
+			count   uint64
+			zero    T
+			pageReq = &query.PageRequest{Limit: opts.Limit}
+		)
+
+		for {
+			// Stop once the maximum number of items has been yielded.
+			if opts.MaxItems > 0 && count >= opts.MaxItems {
+				return
+			}
+
+			// Stop the iteration if the context is done.
+			if err := ctx.Err(); err != nil {
+				yield(zero, err)
+				return
+			}
+
+			// Fetch the next page of items.
+			items, pageRes, err := fetch(ctx, pageReq)
+			if err != nil {
+				yield(zero, err)
+				return
+			}
+
+			for _, item := range items {
+				if opts.MaxItems > 0 && count >= opts.MaxItems {
+					return
+				}
+				if !yield(item, nil) {
+					return
+				}
+
+				count++
+			}
+
+			// Stop when there are no more pages.
+			if pageRes == nil || len(pageRes.NextKey) == 0 {
+				return
+			}
+
+			pageReq = &query.PageRequest{
+				Key:   pageRes.NextKey,
+				Limit: opts.Limit,
+			}
+		}
+	}
+}
+
+// numberedPageFunc fetches a single page of items, numbered from one, along with the total number of items.
+type numberedPageFunc[T any] func(ctx context.Context, page int, perPage *int) ([]T, int, error)
+
+// iterateNumberedPages returns an iterator over the items of all pages returned by the given fetch function,
+// for searches paginated by page number rather than by key, such as those of the CometBFT RPC server.
+// Pages are requested in order until all items have been fetched, the maximum number of items is reached,
+// or the context is done. The Limit of the options sets the number of items per page.
+// The iterator has the shape of iter.Seq2 and yields a non-nil error as its final element if a query fails.
+func iterateNumberedPages[T any](ctx context.Context, opts PageOptions, fetch numberedPageFunc[T]) func(yield func(T, error) bool) {
+	return func(yield func(T, error) bool) {
+		var (
+			count   uint64
+			fetched int
+			perPage *int
+			zero    T
+		)
+
+		if opts.Limit > 0 {
+			limit := int(opts.Limit)
+			perPage = &limit
+		}
+
+		for page := 1; ; page++ {
+			// Stop once the maximum number of items has been yielded.
+			if opts.MaxItems > 0 && count >= opts.MaxItems {
+				return
+			}
+
+			// Stop the iteration if the context is done.
+			if err := ctx.Err(); err != nil {
+				yield(zero, err)
+				return
+			}
+
+			// Fetch the next page of items.
+			items, total, err := fetch(ctx, page, perPage)
+			if err != nil {
+				yield(zero, err)
+				return
+			}
+
+			for _, item := range items {
+				if opts.MaxItems > 0 && count >= opts.MaxItems {
+					return
+				}
+				if !yield(item, nil) {
+					return
+				}
+
+				count++
+			}
+
+			// Stop when there are no more pages.
+			fetched += len(items)
+			if len(items) == 0 || fetched >= total {
+				return
+			}
+		}
+	}
+}
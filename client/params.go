@@ -0,0 +1,110 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	cosmossdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/sentinel-official/hub/v12/types/v1"
+)
+
+// NodeDeposit retrieves the deposit required to register a node.
+// Returns the deposit coin and any error encountered.
+func (c *Client) NodeDeposit(ctx context.Context) (*cosmossdk.Coin, error) {
+	params, err := c.NodeParams(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query node params: %w", err)
+	}
+
+	return &params.Deposit, nil
+}
+
+// ProviderDeposit retrieves the deposit required to register a provider.
+// Returns the deposit coin and any error encountered.
+func (c *Client) ProviderDeposit(ctx context.Context) (*cosmossdk.Coin, error) {
+	params, err := c.ProviderParams(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query provider params: %w", err)
+	}
+
+	return &params.Deposit, nil
+}
+
+// validateMinPrices checks that none of the given prices is lower than the minimum price of the same denom.
+// Prices in denoms without a minimum are accepted, mirroring the checks performed by the node module.
+func validateMinPrices(prices, minPrices v1.Prices) error {
+	for _, minPrice := range minPrices {
+		baseValue, quoteValue := prices.AmountOf(minPrice.Denom)
+		if !baseValue.IsZero() && baseValue.LT(minPrice.BaseValue) {
+			return fmt.Errorf("base value %s of denom %s is lower than minimum %s", baseValue, minPrice.Denom, minPrice.BaseValue)
+		}
+		if !quoteValue.IsZero() && quoteValue.LT(minPrice.QuoteValue) {
+			return fmt.Errorf("quote value %s of denom %s is lower than minimum %s", quoteValue, minPrice.Denom, minPrice.QuoteValue)
+		}
+	}
+
+	return nil
+}
+
+// validateRange checks that the given value lies within the inclusive range [minValue, maxValue].
+func validateRange(name string, value, minValue, maxValue int64) error {
+	if value < minValue {
+		return fmt.Errorf("%s %d is lower than minimum %d", name, value, minValue)
+	}
+	if value > maxValue {
+		return fmt.Errorf("%s %d is greater than maximum %d", name, value, maxValue)
+	}
+
+	return nil
+}
+
+// ValidateNodePrices checks the given gigabyte and hourly prices against the minimum prices of the node module.
+// Returns an error if any of the prices would be rejected by the chain.
+func (c *Client) ValidateNodePrices(ctx context.Context, gigabytePrices, hourlyPrices v1.Prices) error {
+	params, err := c.NodeParams(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to query node params: %w", err)
+	}
+
+	if err := validateMinPrices(gigabytePrices, params.GetMinGigabytePrices()); err != nil {
+		return fmt.Errorf("invalid gigabyte prices: %w", err)
+	}
+	if err := validateMinPrices(hourlyPrices, params.GetMinHourlyPrices()); err != nil {
+		return fmt.Errorf("invalid hourly prices: %w", err)
+	}
+
+	return nil
+}
+
+// ValidateSessionGigabytes checks the given number of gigabytes against the session limits of the node module.
+// Returns an error if a session with the given gigabytes would be rejected by the chain.
+func (c *Client) ValidateSessionGigabytes(ctx context.Context, gigabytes int64) error {
+	params, err := c.NodeParams(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to query node params: %w", err)
+	}
+
+	return validateRange("gigabytes", gigabytes, params.MinSessionGigabytes, params.MaxSessionGigabytes)
+}
+
+// ValidateSessionHours checks the given number of hours against the session limits of the node module.
+// Returns an error if a session with the given hours would be rejected by the chain.
+func (c *Client) ValidateSessionHours(ctx context.Context, hours int64) error {
+	params, err := c.NodeParams(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to query node params: %w", err)
+	}
+
+	return validateRange("hours", hours, params.MinSessionHours, params.MaxSessionHours)
+}
+
+// ValidateLeaseHours checks the given number of hours against the limits of the lease module.
+// Returns an error if a lease with the given hours would be rejected by the chain.
+func (c *Client) ValidateLeaseHours(ctx context.Context, hours int64) error {
+	params, err := c.LeaseParams(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to query lease params: %w", err)
+	}
+
+	return validateRange("hours", hours, params.MinLeaseHours, params.MaxLeaseHours)
+}
@@ -2,10 +2,13 @@ package client
 
 import (
 	"context"
+	"fmt"
 
+	core "github.com/cometbft/cometbft/rpc/core/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/sentinel-official/hub/v12/types"
 	"github.com/sentinel-official/hub/v12/types/v1"
+	nodetypes "github.com/sentinel-official/hub/v12/x/node/types/v3"
 	"github.com/sentinel-official/hub/v12/x/plan/types/v3"
 )
 
@@ -51,6 +54,14 @@ func (c *Client) Plans(ctx context.Context, status v1.Status, pageReq *query.Pag
 	return resp.Plans, resp.Pagination, nil
 }
 
+// AllPlans returns an iterator over all plans filtered by their status.
+// Pages are fetched on demand according to the given options.
+func (c *Client) AllPlans(ctx context.Context, status v1.Status, opts PageOptions) func(yield func(v3.Plan, error) bool) {
+	return iteratePages(ctx, opts, func(ctx context.Context, pageReq *query.PageRequest) ([]v3.Plan, *query.PageResponse, error) {
+		return c.Plans(ctx, status, pageReq)
+	})
+}
+
 // PlansForProvider retrieves a list of plans associated with a specific provider address.
 // Filters results by status and supports pagination.
 // Returns the plans, pagination details, and any error encountered.
@@ -71,3 +82,111 @@ func (c *Client) PlansForProvider(ctx context.Context, provAddr types.ProvAddres
 
 	return resp.Plans, resp.Pagination, nil
 }
+
+// AllPlansForProvider returns an iterator over all plans associated with a specific provider address, filtered by their status.
+// Pages are fetched on demand according to the given options.
+func (c *Client) AllPlansForProvider(ctx context.Context, provAddr types.ProvAddress, status v1.Status, opts PageOptions) func(yield func(v3.Plan, error) bool) {
+	return iteratePages(ctx, opts, func(ctx context.Context, pageReq *query.PageRequest) ([]v3.Plan, *query.PageResponse, error) {
+		return c.PlansForProvider(ctx, provAddr, status, pageReq)
+	})
+}
+
+// isNodeLinkedToPlan checks whether the given node is linked to the plan with the given ID.
+// Iterates over all nodes linked to the plan regardless of their status.
+// Returns true if the node is linked, and any error encountered.
+func (c *Client) isNodeLinkedToPlan(ctx context.Context, id uint64, nodeAddr types.NodeAddress) (linked bool, err error) {
+	c.AllNodesForPlan(ctx, id, v1.StatusUnspecified, PageOptions{})(func(node nodetypes.Node, e error) bool {
+		if e != nil {
+			err = fmt.Errorf("failed to query nodes for plan: %w", e)
+			return false
+		}
+
+		linked = node.Address == nodeAddr.String()
+		return !linked
+	})
+
+	return linked, err
+}
+
+// CreatePlan creates a plan owned by the provider of the signing account.
+// Returns the broadcast result and any error encountered.
+func (c *Client) CreatePlan(ctx context.Context, gigabytes, hours int64, prices v1.Prices, private bool) (*core.ResultBroadcastTx, error) {
+	provAddr, err := c.ProvAddr()
+	if err != nil {
+		return nil, err
+	}
+
+	// Build the message and broadcast it.
+	msg := v3.NewMsgCreatePlanRequest(provAddr, gigabytes, hours, prices, private)
+	return c.BroadcastMsgs(ctx, msg)
+}
+
+// UpdatePlanStatus updates the status of the plan with the given ID.
+// Returns the broadcast result and any error encountered.
+func (c *Client) UpdatePlanStatus(ctx context.Context, id uint64, status v1.Status) (*core.ResultBroadcastTx, error) {
+	provAddr, err := c.ProvAddr()
+	if err != nil {
+		return nil, err
+	}
+
+	// Build the message and broadcast it.
+	msg := v3.NewMsgUpdatePlanStatusRequest(provAddr, id, status)
+	return c.BroadcastMsgs(ctx, msg)
+}
+
+// LinkNode links the given node to the plan with the given ID.
+// Returns an error without broadcasting if the node is already linked to the plan.
+func (c *Client) LinkNode(ctx context.Context, id uint64, nodeAddr types.NodeAddress) (*core.ResultBroadcastTx, error) {
+	provAddr, err := c.ProvAddr()
+	if err != nil {
+		return nil, err
+	}
+
+	// Ensure the node is not linked to the plan yet.
+	linked, err := c.isNodeLinkedToPlan(ctx, id, nodeAddr)
+	if err != nil {
+		return nil, err
+	}
+	if linked {
+		return nil, fmt.Errorf("node %s is already linked to plan %d", nodeAddr, id)
+	}
+
+	// Build the message and broadcast it.
+	msg := v3.NewMsgLinkNodeRequest(provAddr, id, nodeAddr)
+	return c.BroadcastMsgs(ctx, msg)
+}
+
+// UnlinkNode unlinks the given node from the plan with the given ID.
+// Returns an error without broadcasting if the node is not linked to the plan.
+func (c *Client) UnlinkNode(ctx context.Context, id uint64, nodeAddr types.NodeAddress) (*core.ResultBroadcastTx, error) {
+	provAddr, err := c.ProvAddr()
+	if err != nil {
+		return nil, err
+	}
+
+	// Ensure the node is linked to the plan.
+	linked, err := c.isNodeLinkedToPlan(ctx, id, nodeAddr)
+	if err != nil {
+		return nil, err
+	}
+	if !linked {
+		return nil, fmt.Errorf("node %s is not linked to plan %d", nodeAddr, id)
+	}
+
+	// Build the message and broadcast it.
+	msg := v3.NewMsgUnlinkNodeRequest(provAddr, id, nodeAddr)
+	return c.BroadcastMsgs(ctx, msg)
+}
+
+// StartSessionOnPlan subscribes the signing account to the plan with the given ID and starts a session on the given node.
+// Returns the broadcast result and any error encountered.
+func (c *Client) StartSessionOnPlan(ctx context.Context, id uint64, denom string, renewalPricePolicy v1.RenewalPricePolicy, nodeAddr types.NodeAddress) (*core.ResultBroadcastTx, error) {
+	accAddr, err := c.FromAddr()
+	if err != nil {
+		return nil, err
+	}
+
+	// Build the message and broadcast it.
+	msg := v3.NewMsgStartSessionRequest(accAddr, id, denom, renewalPricePolicy, nodeAddr)
+	return c.BroadcastMsgs(ctx, msg)
+}
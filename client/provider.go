@@ -3,16 +3,19 @@ package client
 import (
 	"context"
 
+	core "github.com/cometbft/cometbft/rpc/core/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/sentinel-official/hub/v12/types"
 	"github.com/sentinel-official/hub/v12/types/v1"
 	"github.com/sentinel-official/hub/v12/x/provider/types/v2"
+	"github.com/sentinel-official/hub/v12/x/provider/types/v3"
 )
 
 const (
 	// gRPC methods for querying provider information
-	methodQueryProvider  = "/sentinel.provider.v2.QueryService/QueryProvider"  // Retrieve details of a specific provider
-	methodQueryProviders = "/sentinel.provider.v2.QueryService/QueryProviders" // Retrieve a list of providers with optional filtering
+	methodQueryProvider       = "/sentinel.provider.v2.QueryService/QueryProvider"  // Retrieve details of a specific provider
+	methodQueryProviders      = "/sentinel.provider.v2.QueryService/QueryProviders" // Retrieve a list of providers with optional filtering
+	methodQueryProviderParams = "/sentinel.provider.v2.QueryService/QueryParams"    // Retrieve the parameters of the provider module
 )
 
 // Provider retrieves details of a specific provider by its address.
@@ -49,3 +52,77 @@ func (c *Client) Providers(ctx context.Context, status v1.Status, pageReq *query
 
 	return resp.Providers, resp.Pagination, nil
 }
+
+// AllProviders returns an iterator over all providers filtered by their status.
+// Pages are fetched on demand according to the given options.
+func (c *Client) AllProviders(ctx context.Context, status v1.Status, opts PageOptions) func(yield func(v2.Provider, error) bool) {
+	return iteratePages(ctx, opts, func(ctx context.Context, pageReq *query.PageRequest) ([]v2.Provider, *query.PageResponse, error) {
+		return c.Providers(ctx, status, pageReq)
+	})
+}
+
+// ProviderParams retrieves the parameters of the provider module.
+// Returns the module parameters and any error encountered.
+func (c *Client) ProviderParams(ctx context.Context) (*v2.Params, error) {
+	var (
+		resp v2.QueryParamsResponse
+		req  = &v2.QueryParamsRequest{}
+	)
+
+	// Perform the gRPC query to fetch the module parameters.
+	if err := c.QueryGRPC(ctx, methodQueryProviderParams, req, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp.Params, nil
+}
+
+// ProvAddr retrieves the provider address derived from the account on whose behalf messages are built.
+// Returns the provider address or an error if the key cannot be found.
+func (c *Client) ProvAddr() (types.ProvAddress, error) {
+	accAddr, err := c.FromAddr()
+	if err != nil {
+		return nil, err
+	}
+
+	return accAddr.Bytes(), nil
+}
+
+// RegisterProvider registers the signing account as a provider with the given details.
+// Returns the broadcast result and any error encountered.
+func (c *Client) RegisterProvider(ctx context.Context, name, identity, website, description string) (*core.ResultBroadcastTx, error) {
+	accAddr, err := c.FromAddr()
+	if err != nil {
+		return nil, err
+	}
+
+	// Build the message and broadcast it.
+	msg := v3.NewMsgRegisterProviderRequest(accAddr, name, identity, website, description)
+	return c.BroadcastMsgs(ctx, msg)
+}
+
+// UpdateProviderDetails updates the details of the provider owned by the signing account.
+// Returns the broadcast result and any error encountered.
+func (c *Client) UpdateProviderDetails(ctx context.Context, name, identity, website, description string) (*core.ResultBroadcastTx, error) {
+	provAddr, err := c.ProvAddr()
+	if err != nil {
+		return nil, err
+	}
+
+	// Build the message and broadcast it.
+	msg := v3.NewMsgUpdateProviderDetailsRequest(provAddr, name, identity, website, description)
+	return c.BroadcastMsgs(ctx, msg)
+}
+
+// UpdateProviderStatus updates the status of the provider owned by the signing account.
+// Returns the broadcast result and any error encountered.
+func (c *Client) UpdateProviderStatus(ctx context.Context, status v1.Status) (*core.ResultBroadcastTx, error) {
+	provAddr, err := c.ProvAddr()
+	if err != nil {
+		return nil, err
+	}
+
+	// Build the message and broadcast it.
+	msg := v3.NewMsgUpdateProviderStatusRequest(provAddr, status)
+	return c.BroadcastMsgs(ctx, msg)
+}
@@ -0,0 +1,216 @@
+package client
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	grpctypes "github.com/cosmos/cosmos-sdk/types/grpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/keepalive"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// Querier performs gRPC queries against the chain over a specific transport.
+// Requests and responses are passed as protobuf encoded bytes, so that encoding stays with the Client.
+type Querier interface {
+	// Query performs a single attempt of the query of the given gRPC method at the given height, or at the latest
+	// height if zero. Errors returned by the chain are reported as *ABCIError or *GRPCError.
+	Query(ctx context.Context, method string, height int64, data []byte) ([]byte, error)
+}
+
+// querierOrDefault returns the first of the given queriers which is set, or the ABCI querier of the Client.
+func (c *Client) querierOrDefault(queriers ...Querier) Querier {
+	for _, q := range queriers {
+		if q != nil {
+			return q
+		}
+	}
+
+	return NewABCIQuerier(c)
+}
+
+// WithQuerier sets the querier used by the gRPC queries and returns the updated Client.
+// If no querier is set, queries are tunneled through the ABCI query endpoint of the RPC servers.
+func (c *Client) WithQuerier(querier Querier) *Client {
+	c.querier = querier
+	return c
+}
+
+// WithTxSimulateQuerier sets the querier used to simulate transactions and returns the updated Client.
+// If no querier is set, simulations use the querier of the gRPC queries.
+func (c *Client) WithTxSimulateQuerier(querier Querier) *Client {
+	c.txSimulateQuerier = querier
+	return c
+}
+
+// ABCIQuerier performs gRPC queries through the ABCI query endpoint of the RPC servers configured on a Client.
+type ABCIQuerier struct {
+	c *Client
+}
+
+// NewABCIQuerier creates a querier that tunnels gRPC queries through the RPC servers of the given Client.
+func NewABCIQuerier(c *Client) *ABCIQuerier {
+	return &ABCIQuerier{c: c}
+}
+
+// Query performs the query of the given gRPC method as an ABCI query on the healthiest RPC endpoint.
+// Returns the response value or an error.
+func (q *ABCIQuerier) Query(ctx context.Context, method string, height int64, data []byte) ([]byte, error) {
+	reply, err := q.c.abciQuery(ctx, method, data, height)
+	if err != nil {
+		return nil, err
+	}
+
+	// Report the errors returned by the application along with their codespace and code.
+	if reply.IsErr() {
+		return nil, &ABCIError{
+			Codespace: reply.Codespace,
+			Code:      reply.Code,
+			Log:       reply.Log,
+		}
+	}
+
+	return reply.Value, nil
+}
+
+// rawCodec is a gRPC codec passing already encoded protobuf messages through unchanged.
+type rawCodec struct{}
+
+// Marshal returns the given encoded message.
+func (rawCodec) Marshal(v any) ([]byte, error) {
+	buf, ok := v.([]byte)
+	if !ok {
+		return nil, fmt.Errorf("invalid message type %T", v)
+	}
+
+	return buf, nil
+}
+
+// Unmarshal stores a copy of the encoded message in the given byte slice.
+func (rawCodec) Unmarshal(data []byte, v any) error {
+	buf, ok := v.(*[]byte)
+	if !ok {
+		return fmt.Errorf("invalid message type %T", v)
+	}
+
+	*buf = append((*buf)[:0], data...)
+	return nil
+}
+
+// Name returns the content subtype of the codec, which is protobuf on the wire.
+func (rawCodec) Name() string {
+	return "proto"
+}
+
+// GRPCQuerier performs gRPC queries directly against the gRPC server of a node.
+type GRPCQuerier struct {
+	addr             string        // Address of the gRPC server
+	keepaliveTime    time.Duration // Interval between keepalive pings, no pings if zero
+	keepaliveTimeout time.Duration // Time to wait for a keepalive ping to be acknowledged
+	tlsConfig        *tls.Config   // TLS configuration, insecure connection if nil
+
+	mu   sync.Mutex
+	conn *grpc.ClientConn
+}
+
+// NewGRPCQuerier creates a querier for the gRPC server at the given address.
+// The connection is insecure unless a TLS configuration is set, and is established on first use.
+func NewGRPCQuerier(addr string) *GRPCQuerier {
+	return &GRPCQuerier{addr: addr}
+}
+
+// WithKeepalive sets the interval between keepalive pings and the time to wait for their acknowledgment,
+// and returns the updated GRPCQuerier.
+func (q *GRPCQuerier) WithKeepalive(interval, timeout time.Duration) *GRPCQuerier {
+	q.keepaliveTime = interval
+	q.keepaliveTimeout = timeout
+	return q
+}
+
+// WithTLSConfig sets the TLS configuration of the connection and returns the updated GRPCQuerier.
+func (q *GRPCQuerier) WithTLSConfig(cfg *tls.Config) *GRPCQuerier {
+	q.tlsConfig = cfg
+	return q
+}
+
+// client returns the connection to the gRPC server, creating it on first use.
+func (q *GRPCQuerier) client() (*grpc.ClientConn, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if q.conn != nil {
+		return q.conn, nil
+	}
+
+	creds := insecure.NewCredentials()
+	if q.tlsConfig != nil {
+		creds = credentials.NewTLS(q.tlsConfig)
+	}
+
+	opts := []grpc.DialOption{
+		grpc.WithTransportCredentials(creds),
+	}
+	if q.keepaliveTime > 0 {
+		opts = append(opts, grpc.WithKeepaliveParams(keepalive.ClientParameters{
+			Time:                q.keepaliveTime,
+			Timeout:             q.keepaliveTimeout,
+			PermitWithoutStream: true,
+		}))
+	}
+
+	conn, err := grpc.NewClient(q.addr, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	q.conn = conn
+	return q.conn, nil
+}
+
+// Close closes the connection to the gRPC server, if one was established.
+func (q *GRPCQuerier) Close() error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if q.conn == nil {
+		return nil
+	}
+
+	err := q.conn.Close()
+	q.conn = nil
+
+	return err
+}
+
+// Query invokes the given gRPC method on the server, passing the height in the block height metadata.
+// Returns the encoded response or an error.
+func (q *GRPCQuerier) Query(ctx context.Context, method string, height int64, data []byte) ([]byte, error) {
+	conn, err := q.client()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create grpc client: %w", err)
+	}
+
+	// Request the state at the given height, if any.
+	if height > 0 {
+		ctx = metadata.AppendToOutgoingContext(ctx, grpctypes.GRPCBlockHeightHeader, strconv.FormatInt(height, 10))
+	}
+
+	var buf []byte
+	if err := conn.Invoke(ctx, method, data, &buf, grpc.ForceCodec(rawCodec{})); err != nil {
+		// Report the errors returned by the server along with their status.
+		if s, ok := status.FromError(err); ok {
+			return nil, &GRPCError{Status: s}
+		}
+
+		return nil, fmt.Errorf("failed to invoke grpc method: %w", err)
+	}
+
+	return buf, nil
+}
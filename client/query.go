@@ -4,74 +4,120 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"strings"
+	"time"
 
 	"github.com/avast/retry-go/v4"
 	abci "github.com/cometbft/cometbft/abci/types"
 	"github.com/cometbft/cometbft/libs/bytes"
 	"github.com/cometbft/cometbft/rpc/client"
-	core "github.com/cometbft/cometbft/rpc/core/types"
 	"github.com/cosmos/cosmos-sdk/codec"
 )
 
-// IsNotFoundError checks if the given error string indicates a gRPC NotFound error.
+// IsNotFoundError returns nil if the given error is classified as ErrNotFound, and the error otherwise.
 func IsNotFoundError(err error) error {
-	// Check if the error string contains "rpc error: code = NotFound"
-	if strings.Contains(err.Error(), "rpc error: code = NotFound") {
+	if errors.Is(err, ErrNotFound) {
 		return nil
 	}
 
 	return err
 }
 
-// ABCIQueryWithOptions performs an ABCI query with configurable options.
-// It retries the query in case of failures based on the Client's retry configuration.
+// abciQuery performs a single ABCI query at the given height on the healthiest RPC endpoint, recording the outcome
+// so that a failing endpoint is skipped on retry. When a light client is set, the result is verified against it.
 // Returns the ABCI query response or an error.
-func (c *Client) ABCIQueryWithOptions(ctx context.Context, path string, data bytes.HexBytes) (*abci.ResponseQuery, error) {
-	var result *core.ResultABCIQuery
-
-	// Define the function to perform the ABCI query.
-	queryFunc := func() error {
-		// Get the RPC client for querying.
-		http, err := c.HTTP()
-		if err != nil {
-			return fmt.Errorf("failed to create rpc client: %w", err)
+func (c *Client) abciQuery(ctx context.Context, path string, data bytes.HexBytes, height int64) (*abci.ResponseQuery, error) {
+	// Only store key queries return proofs. Other paths are performed unverified if allowed, and otherwise fail
+	// without retrying, as retrying them is pointless.
+	verify := c.queryLightClient != nil
+	if verify {
+		if _, err := storeKeyPath(path, nil); err != nil {
+			if !c.queryAllowUnverified {
+				return nil, retry.Unrecoverable(err)
+			}
+
+			verify = false
 		}
+	}
 
-		// Configure the query options.
-		opts := client.ABCIQueryOptions{
-			Height: c.queryHeight,
-			Prove:  c.queryProve,
-		}
+	// Get the RPC client of the healthiest endpoint for querying.
+	endpoint, http, err := c.rpc()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create rpc client: %w", err)
+	}
+
+	// Configure the query options.
+	opts := client.ABCIQueryOptions{
+		Height: height,
+		Prove:  c.queryProve,
+	}
 
-		// Perform the query and store the result.
-		result, err = http.ABCIQueryWithOptions(ctx, path, data, opts)
-		if err != nil {
-			return fmt.Errorf("failed to perform abci query: %w", err)
+	// Verified queries always request a proof, at a height whose app hash is covered by a trusted header.
+	if verify {
+		opts.Prove = true
+		if opts.Height == 0 {
+			opts.Height, err = c.verifiedQueryHeight(ctx)
+			if err != nil {
+				return nil, err
+			}
 		}
+	}
 
-		return nil
+	// Perform the query.
+	start := time.Now()
+	result, err := http.ABCIQueryWithOptions(ctx, path, data, opts)
+	if err != nil {
+		endpoint.observe(ctx, start, 0, err)
+		return nil, fmt.Errorf("failed to perform abci query: %w: %w", ErrUnavailable, err)
 	}
 
-	// Retry the query using the configured maximum retries and delay.
-	if err := retry.Do(
-		queryFunc,
-		retry.Attempts(c.queryRetries),
-		retry.Delay(c.queryRetryDelay),
-		retry.DelayType(retry.FixedDelay),
-		retry.LastErrorOnly(true),
-	); err != nil {
-		return nil, fmt.Errorf("query failed after retries: %w", err)
+	// Reject results which cannot be verified, treating them as a failure of the endpoint.
+	if verify {
+		if err := c.verifyQueryResponse(ctx, path, data, opts.Height, &result.Response); err != nil {
+			endpoint.observe(ctx, start, 0, err)
+			return nil, fmt.Errorf("failed to verify abci query: %w", err)
+		}
 	}
 
-	// Return nil if no result was produced.
-	if result == nil {
-		return nil, nil
+	// Only the latest height is meaningful for tracking how far the endpoint lags behind. The application reports
+	// the height the query was served at, which is its latest block for queries without a height.
+	var latestHeight int64
+	if opts.Height == 0 {
+		latestHeight = result.Response.Height
 	}
 
+	endpoint.observe(ctx, start, latestHeight, nil)
 	return &result.Response, nil
 }
 
+// retryQuery runs the given query function, retrying it on failures based on the Client's retry policy.
+// Errors which cannot succeed on retry, such as those returned by the application, end the retries immediately.
+func (c *Client) retryQuery(ctx context.Context, queryFunc func() error) error {
+	if err := c.queryRetryPolicy().do(ctx, queryFunc, nil); err != nil {
+		return fmt.Errorf("query failed after retries: %w", err)
+	}
+
+	return nil
+}
+
+// ABCIQueryWithOptions performs an ABCI query with configurable options.
+// It retries the query in case of failures based on the Client's retry configuration.
+// Returns the ABCI query response or an error.
+func (c *Client) ABCIQueryWithOptions(ctx context.Context, path string, data bytes.HexBytes) (*abci.ResponseQuery, error) {
+	var result *abci.ResponseQuery
+
+	// Define the function to perform the ABCI query.
+	queryFunc := func() (err error) {
+		result, err = c.abciQuery(ctx, path, data, c.queryHeight)
+		return err
+	}
+
+	if err := c.retryQuery(ctx, queryFunc); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
 // QueryKey performs an ABCI query for a specific key in a store.
 // Constructs the query path and delegates the query to ABCIQueryWithOptions.
 // Returns the query response or an error.
@@ -104,32 +150,41 @@ func (c *Client) QuerySubspace(ctx context.Context, store string, data bytes.Hex
 	return reply, nil
 }
 
-// QueryGRPC performs a gRPC query using ABCI with configurable options.
-// Marshals the request, queries via ABCI, and unmarshals the response.
+// QueryGRPC performs a gRPC query through the configured querier, which defaults to ABCI.
+// Fresh responses are served from the query cache, if set, unless the query height is set.
+// Marshals the request, performs the query, and unmarshals the response.
 // Returns an error if any step fails.
 func (c *Client) QueryGRPC(ctx context.Context, method string, req, resp codec.ProtoMarshaler) error {
+	querier := c.querierOrDefault(c.querier)
+	if c.queryCache != nil {
+		querier = &cachingQuerier{cache: c.queryCache, next: querier}
+	}
+
+	return c.queryGRPC(ctx, querier, method, req, resp)
+}
+
+// queryGRPC performs a gRPC query through the given querier, retrying it in case of failures.
+// Returns an error if any step fails.
+func (c *Client) queryGRPC(ctx context.Context, querier Querier, method string, req, resp codec.ProtoMarshaler) error {
 	// Marshal the request into bytes.
 	data, err := c.protoCodec.Marshal(req)
 	if err != nil {
 		return fmt.Errorf("failed to marshal request: %w", err)
 	}
 
-	// Perform the query using ABCIQueryWithOptions.
-	reply, err := c.ABCIQueryWithOptions(ctx, method, data)
-	if err != nil {
-		return fmt.Errorf("failed to perform grpc query: %w", err)
+	// Define the function to perform the query.
+	var buf []byte
+	queryFunc := func() (err error) {
+		buf, err = querier.Query(ctx, method, c.queryHeight, data)
+		return err
 	}
 
-	// Check for a nil reply.
-	if reply == nil {
-		return errors.New("nil reply")
-	}
-	if reply.IsErr() {
-		return errors.New(reply.Log)
+	if err := c.retryQuery(ctx, queryFunc); err != nil {
+		return fmt.Errorf("failed to perform grpc query: %w", err)
 	}
 
 	// Unmarshal the response value into the provided response object.
-	if err := c.protoCodec.Unmarshal(reply.Value, resp); err != nil {
+	if err := c.protoCodec.Unmarshal(buf, resp); err != nil {
 		return fmt.Errorf("failed to unmarshal response: %w", err)
 	}
 
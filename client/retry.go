@@ -0,0 +1,168 @@
+package client
+
+import (
+	"context"
+	"time"
+
+	"github.com/avast/retry-go/v4"
+)
+
+const (
+	// Default number of attempts per call, including the first one
+	defaultRetryAttempts = 3
+	// Default bounds of the exponential backoff between retries
+	defaultRetryDelay    = 500 * time.Millisecond
+	defaultRetryMaxDelay = 5 * time.Second
+	// Default upper bound of the random delay added to each retry
+	defaultRetryMaxJitter = 250 * time.Millisecond
+)
+
+// RetryPolicy configures how failed queries and broadcasts are retried.
+// By default, failures classified as retryable by IsRetryable are retried up to three attempts in total, with an
+// exponential backoff and jitter between them.
+type RetryPolicy struct {
+	attempts   uint             // Maximum number of attempts per call, unlimited if zero
+	backoff    bool             // Flag for doubling the delay after each failed attempt
+	delay      time.Duration    // Delay before the first retry
+	maxDelay   time.Duration    // Upper bound of the delay between retries, unbounded if zero
+	maxElapsed time.Duration    // Maximum total time spent on a call, unbounded if zero
+	maxJitter  time.Duration    // Upper bound of the random delay added to each retry, no jitter if zero
+	retryable  func(error) bool // Predicate deciding which errors are retried
+}
+
+// NewRetryPolicy creates a retry policy retrying the errors classified as retryable by IsRetryable, making up to
+// three attempts per call with an exponential backoff from 500ms up to 5s and up to 250ms of jitter.
+func NewRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		attempts:  defaultRetryAttempts,
+		backoff:   true,
+		delay:     defaultRetryDelay,
+		maxDelay:  defaultRetryMaxDelay,
+		maxJitter: defaultRetryMaxJitter,
+		retryable: IsRetryable,
+	}
+}
+
+// WithAttempts sets the maximum number of attempts per call, including the first one, and returns the updated
+// RetryPolicy. Zero attempts retry until the call succeeds, the maximum elapsed time is reached or the context is
+// done, and must be set explicitly.
+func (p *RetryPolicy) WithAttempts(attempts uint) *RetryPolicy {
+	p.attempts = attempts
+	return p
+}
+
+// WithDelay sets a fixed delay between retries and returns the updated RetryPolicy.
+func (p *RetryPolicy) WithDelay(delay time.Duration) *RetryPolicy {
+	p.backoff = false
+	p.delay = delay
+	return p
+}
+
+// WithBackoff sets an exponential backoff starting at the given delay and doubling after each failed attempt,
+// up to maxDelay if non-zero, and returns the updated RetryPolicy.
+func (p *RetryPolicy) WithBackoff(delay, maxDelay time.Duration) *RetryPolicy {
+	p.backoff = true
+	p.delay = delay
+	p.maxDelay = maxDelay
+	return p
+}
+
+// WithJitter sets the upper bound of the random delay added to each retry and returns the updated RetryPolicy.
+// Jitter spreads out the retries of concurrent callers failing at the same time.
+func (p *RetryPolicy) WithJitter(maxJitter time.Duration) *RetryPolicy {
+	p.maxJitter = maxJitter
+	return p
+}
+
+// WithMaxElapsed sets the maximum total time spent on a call, after which no further attempts are made,
+// and returns the updated RetryPolicy.
+func (p *RetryPolicy) WithMaxElapsed(maxElapsed time.Duration) *RetryPolicy {
+	p.maxElapsed = maxElapsed
+	return p
+}
+
+// WithRetryable sets the predicate deciding which errors are retried and returns the updated RetryPolicy.
+// Errors marked with retry.Unrecoverable are never retried.
+func (p *RetryPolicy) WithRetryable(retryable func(error) bool) *RetryPolicy {
+	p.retryable = retryable
+	return p
+}
+
+// do runs the given function, retrying it on the errors accepted by both the policy's predicate and retryIf,
+// if set, until it succeeds, the attempts or the maximum elapsed time are exhausted, or the context is done.
+// Returns the last error of the function or the error of the context.
+func (p *RetryPolicy) do(ctx context.Context, fn func() error, retryIf func(error) bool) error {
+	start := time.Now()
+
+	delayType := retry.FixedDelay
+	if p.backoff {
+		delayType = retry.BackOffDelay
+	}
+	if p.maxJitter > 0 {
+		delayType = retry.CombineDelay(delayType, retry.RandomDelay)
+	}
+
+	return retry.Do(
+		fn,
+		retry.Attempts(p.attempts),
+		retry.Context(ctx),
+		retry.Delay(p.delay),
+		retry.MaxDelay(p.maxDelay),
+		retry.MaxJitter(p.maxJitter),
+		retry.DelayType(func(n uint, err error, config *retry.Config) time.Duration {
+			d := delayType(n, err, config)
+
+			// Never wait beyond the maximum elapsed time.
+			if p.maxElapsed > 0 {
+				d = min(d, max(p.maxElapsed-time.Since(start), 0))
+			}
+
+			return d
+		}),
+		retry.LastErrorOnly(true),
+		retry.RetryIf(func(err error) bool {
+			if p.maxElapsed > 0 && time.Since(start) >= p.maxElapsed {
+				return false
+			}
+			if !retry.IsRecoverable(err) {
+				return false
+			}
+			if p.retryable != nil && !p.retryable(err) {
+				return false
+			}
+
+			return retryIf == nil || retryIf(err)
+		}),
+	)
+}
+
+// WithRetryPolicy sets the policy for retrying failed queries and broadcasts and returns the updated Client.
+// Broadcasts are only retried when they fail because the mempool is full or the request timed out.
+// If no policy is set, queries are retried based on the query retries and retry delay, and broadcasts are
+// not retried.
+func (c *Client) WithRetryPolicy(policy *RetryPolicy) *Client {
+	c.retryPolicy = policy
+	return c
+}
+
+// queryRetryPolicy returns the policy for retrying failed queries.
+func (c *Client) queryRetryPolicy() *RetryPolicy {
+	if c.retryPolicy != nil {
+		return c.retryPolicy
+	}
+
+	return NewRetryPolicy().
+		WithAttempts(c.queryRetries).
+		WithDelay(c.queryRetryDelay).
+		WithJitter(0)
+}
+
+// retryBroadcast runs the given broadcast function, retrying it on transient failures based on the Client's
+// retry policy. The function is run once if no policy is set.
+func (c *Client) retryBroadcast(ctx context.Context, broadcastFunc func() error) error {
+	if c.retryPolicy == nil {
+		return broadcastFunc()
+	}
+
+	return c.retryPolicy.do(ctx, broadcastFunc, isTransientBroadcastError)
+}
@@ -0,0 +1,35 @@
+package client_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/sentinel-official/sentinel-go-sdk/client"
+)
+
+func TestDefaultRetryPolicyIsBounded(t *testing.T) {
+	ch := newTestChain(t)
+
+	// Count the requests to an RPC server which is always unavailable.
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		requests.Add(1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	t.Cleanup(server.Close)
+
+	c, _ := newTestClient(t, ch, "alice")
+	c.WithRPCAddr(server.URL).
+		WithRetryPolicy(client.NewRetryPolicy().WithBackoff(time.Millisecond, time.Millisecond).WithJitter(0))
+
+	if _, err := c.Node(context.Background(), newTestNodeAddr(1)); err == nil {
+		t.Fatal("Node succeeded, want an error as the server is unavailable")
+	}
+	if n := requests.Load(); n != 3 {
+		t.Fatalf("server received %d requests, want 3 attempts", n)
+	}
+}
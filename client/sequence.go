@@ -0,0 +1,78 @@
+package client
+
+import (
+	"sync"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	core "github.com/cometbft/cometbft/rpc/core/types"
+	cosmossdk "github.com/cosmos/cosmos-sdk/types"
+	auth "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// accountSequence caches the account of a signing key along with its next sequence.
+// The embedded mutex serialises signing and broadcasting of transactions from the same account.
+type accountSequence struct {
+	sync.Mutex
+	account auth.AccountI // Cached account, nil when the sequence must be resynced from the chain
+}
+
+// get returns the cached account, or nil if it must be resynced.
+func (s *accountSequence) get() auth.AccountI {
+	return s.account
+}
+
+// set caches the given account as the source of the next sequence.
+func (s *accountSequence) set(account auth.AccountI) {
+	s.account = account
+}
+
+// increment advances the cached sequence after a transaction has been accepted into the mempool.
+func (s *accountSequence) increment() {
+	if s.account == nil {
+		return
+	}
+
+	// Drop the cached account if the sequence cannot be updated locally.
+	if err := s.account.SetSequence(s.account.GetSequence() + 1); err != nil {
+		s.account = nil
+	}
+}
+
+// reset drops the cached account so that the next transaction resyncs the sequence from the chain.
+func (s *accountSequence) reset() {
+	s.account = nil
+}
+
+// accountSequence returns the sequence cache for the given account address, creating it if necessary.
+func (c *Client) accountSequence(accAddr cosmossdk.AccAddress) *accountSequence {
+	c.sequencesMu.Lock()
+	defer c.sequencesMu.Unlock()
+
+	if c.sequences == nil {
+		c.sequences = make(map[string]*accountSequence)
+	}
+
+	seq, ok := c.sequences[accAddr.String()]
+	if !ok {
+		seq = &accountSequence{}
+		c.sequences[accAddr.String()] = seq
+	}
+
+	return seq
+}
+
+// ResetSequence drops the cached sequence of the given account address.
+// The next transaction signed by the account queries the sequence from the chain.
+func (c *Client) ResetSequence(accAddr cosmossdk.AccAddress) {
+	seq := c.accountSequence(accAddr)
+
+	seq.Lock()
+	defer seq.Unlock()
+
+	seq.reset()
+}
+
+// isSequenceMismatch checks if the broadcast result indicates that the transaction was signed with a wrong sequence.
+func isSequenceMismatch(res *core.ResultBroadcastTx) bool {
+	return res.Code != abci.CodeTypeOK && classifyABCIError(res.Codespace, res.Code) == ErrSequenceMismatch
+}
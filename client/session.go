@@ -2,11 +2,19 @@ package client
 
 import (
 	"context"
+	"fmt"
+	"time"
 
+	"cosmossdk.io/math"
+	core "github.com/cometbft/cometbft/rpc/core/types"
 	cosmossdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	sentinelhub "github.com/sentinel-official/hub/v12/types"
+	sessiontypes "github.com/sentinel-official/hub/v12/x/session/types"
+	"github.com/sentinel-official/hub/v12/x/session/types/v2"
 	"github.com/sentinel-official/hub/v12/x/session/types/v3"
+
+	"github.com/sentinel-official/sentinel-go-sdk/types"
 )
 
 const (
@@ -17,11 +25,17 @@ const (
 	methodQuerySessionsForNode                   = "/sentinel.session.v3.QueryService/QuerySessionsForNode"         // Retrieve sessions associated with a specific node
 	methodQuerySessionsForSubscription           = "/sentinel.session.v3.QueryService/QuerySessionsForSubscription" // Retrieve sessions associated with a specific subscription
 	methodQuerySessionsForSubscriptionAllocation = "/sentinel.session.v3.QueryService/QuerySessionsForAllocation"   // Retrieve sessions for a subscription and account
+	methodQuerySessionParams                     = "/sentinel.session.v2.QueryService/QueryParams"                  // Retrieve the parameters of the session module
 )
 
 // Session retrieves details of a specific session by its ID.
+// When a light client is set, the session is read from its store key so that it can be verified.
 // Returns the session details and any error encountered.
 func (c *Client) Session(ctx context.Context, id uint64) (res v3.Session, err error) {
+	if c.queryLightClient != nil {
+		return c.verifiedSession(ctx, id)
+	}
+
 	var (
 		resp v3.QuerySessionResponse
 		req  = &v3.QuerySessionRequest{Id: id}
@@ -40,6 +54,26 @@ func (c *Client) Session(ctx context.Context, id uint64) (res v3.Session, err er
 	return res, nil
 }
 
+// verifiedSession retrieves details of a specific session by reading its store key with a proof.
+// The session is returned as stored, without the maximum bytes and duration derived from the allocation of its
+// subscription at query time.
+// Returns the session details, nil if the session does not exist, and any error encountered.
+func (c *Client) verifiedSession(ctx context.Context, id uint64) (res v3.Session, err error) {
+	value, err := c.queryVerifiedKey(ctx, sessiontypes.ModuleName, sessiontypes.SessionKey(id))
+	if err != nil {
+		return nil, err
+	}
+	if value == nil {
+		return nil, nil
+	}
+
+	if err := c.protoCodec.UnmarshalInterface(value, &res); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal session: %w", err)
+	}
+
+	return res, nil
+}
+
 // Sessions retrieves a paginated list of all sessions.
 // Returns the sessions, pagination details, and any error encountered.
 func (c *Client) Sessions(ctx context.Context, pageReq *query.PageRequest) (res []v3.Session, pageRes *query.PageResponse, err error) {
@@ -64,6 +98,14 @@ func (c *Client) Sessions(ctx context.Context, pageReq *query.PageRequest) (res
 	return res, resp.Pagination, nil
 }
 
+// AllSessions returns an iterator over all sessions.
+// Pages are fetched on demand according to the given options.
+func (c *Client) AllSessions(ctx context.Context, opts PageOptions) func(yield func(v3.Session, error) bool) {
+	return iteratePages(ctx, opts, func(ctx context.Context, pageReq *query.PageRequest) ([]v3.Session, *query.PageResponse, error) {
+		return c.Sessions(ctx, pageReq)
+	})
+}
+
 // SessionsForAccount retrieves sessions associated with a specific account address.
 // Returns the sessions, pagination details, and any error encountered.
 func (c *Client) SessionsForAccount(ctx context.Context, accAddr cosmossdk.AccAddress, pageReq *query.PageRequest) (res []v3.Session, pageRes *query.PageResponse, err error) {
@@ -91,6 +133,14 @@ func (c *Client) SessionsForAccount(ctx context.Context, accAddr cosmossdk.AccAd
 	return res, resp.Pagination, nil
 }
 
+// AllSessionsForAccount returns an iterator over all sessions associated with a specific account address.
+// Pages are fetched on demand according to the given options.
+func (c *Client) AllSessionsForAccount(ctx context.Context, accAddr cosmossdk.AccAddress, opts PageOptions) func(yield func(v3.Session, error) bool) {
+	return iteratePages(ctx, opts, func(ctx context.Context, pageReq *query.PageRequest) ([]v3.Session, *query.PageResponse, error) {
+		return c.SessionsForAccount(ctx, accAddr, pageReq)
+	})
+}
+
 // SessionsForNode retrieves sessions associated with a specific node address.
 // Returns the sessions, pagination details, and any error encountered.
 func (c *Client) SessionsForNode(ctx context.Context, nodeAddr sentinelhub.NodeAddress, pageReq *query.PageRequest) (res []v3.Session, pageRes *query.PageResponse, err error) {
@@ -118,6 +168,14 @@ func (c *Client) SessionsForNode(ctx context.Context, nodeAddr sentinelhub.NodeA
 	return res, resp.Pagination, nil
 }
 
+// AllSessionsForNode returns an iterator over all sessions associated with a specific node address.
+// Pages are fetched on demand according to the given options.
+func (c *Client) AllSessionsForNode(ctx context.Context, nodeAddr sentinelhub.NodeAddress, opts PageOptions) func(yield func(v3.Session, error) bool) {
+	return iteratePages(ctx, opts, func(ctx context.Context, pageReq *query.PageRequest) ([]v3.Session, *query.PageResponse, error) {
+		return c.SessionsForNode(ctx, nodeAddr, pageReq)
+	})
+}
+
 // SessionsForSubscription retrieves sessions associated with a specific subscription ID.
 // Returns the sessions, pagination details, and any error encountered.
 func (c *Client) SessionsForSubscription(ctx context.Context, id uint64, pageReq *query.PageRequest) (res []v3.Session, pageRes *query.PageResponse, err error) {
@@ -145,6 +203,14 @@ func (c *Client) SessionsForSubscription(ctx context.Context, id uint64, pageReq
 	return res, resp.Pagination, nil
 }
 
+// AllSessionsForSubscription returns an iterator over all sessions associated with a specific subscription ID.
+// Pages are fetched on demand according to the given options.
+func (c *Client) AllSessionsForSubscription(ctx context.Context, id uint64, opts PageOptions) func(yield func(v3.Session, error) bool) {
+	return iteratePages(ctx, opts, func(ctx context.Context, pageReq *query.PageRequest) ([]v3.Session, *query.PageResponse, error) {
+		return c.SessionsForSubscription(ctx, id, pageReq)
+	})
+}
+
 // SessionsForSubscriptionAllocation retrieves sessions associated with a specific subscription ID and account address.
 // Returns the sessions, pagination details, and any error encountered.
 func (c *Client) SessionsForSubscriptionAllocation(ctx context.Context, id uint64, accAddr cosmossdk.AccAddress, pageReq *query.PageRequest) (res []v3.Session, pageRes *query.PageResponse, err error) {
@@ -172,3 +238,87 @@ func (c *Client) SessionsForSubscriptionAllocation(ctx context.Context, id uint6
 
 	return res, resp.Pagination, nil
 }
+
+// AllSessionsForSubscriptionAllocation returns an iterator over all sessions associated with a specific subscription ID and account address.
+// Pages are fetched on demand according to the given options.
+func (c *Client) AllSessionsForSubscriptionAllocation(ctx context.Context, id uint64, accAddr cosmossdk.AccAddress, opts PageOptions) func(yield func(v3.Session, error) bool) {
+	return iteratePages(ctx, opts, func(ctx context.Context, pageReq *query.PageRequest) ([]v3.Session, *query.PageResponse, error) {
+		return c.SessionsForSubscriptionAllocation(ctx, id, accAddr, pageReq)
+	})
+}
+
+// SessionParams retrieves the parameters of the session module.
+// Returns the module parameters and any error encountered.
+func (c *Client) SessionParams(ctx context.Context) (*v2.Params, error) {
+	var (
+		resp v2.QueryParamsResponse
+		req  = &v2.QueryParamsRequest{}
+	)
+
+	// Perform the gRPC query to fetch the module parameters.
+	if err := c.QueryGRPC(ctx, methodQuerySessionParams, req, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp.Params, nil
+}
+
+// NewSessionProof creates the proof of bandwidth and duration consumed within a session from the given peer statistic.
+func NewSessionProof(id uint64, stat *types.PeerStatistic, duration time.Duration) *v3.Proof {
+	return &v3.Proof{
+		ID:            id,
+		DownloadBytes: math.NewInt(stat.DownloadBytes),
+		UploadBytes:   math.NewInt(stat.UploadBytes),
+		Duration:      duration,
+	}
+}
+
+// NewMsgUpdateSessionRequest creates a session update message for the given node from the proof and its optional signature.
+func NewMsgUpdateSessionRequest(nodeAddr sentinelhub.NodeAddress, proof *v3.Proof, signature []byte) *v3.MsgUpdateSessionRequest {
+	return v3.NewMsgUpdateSessionRequest(nodeAddr, proof.ID, proof.DownloadBytes, proof.UploadBytes, proof.Duration, signature)
+}
+
+// SignSessionProof signs the session proof using the key identified by the given name.
+// The signature is produced by the account that owns the session and is verified by the chain against its public key.
+// Returns the signature and any error encountered.
+func (c *Client) SignSessionProof(name string, proof *v3.Proof) ([]byte, error) {
+	// Marshal the proof into the bytes verified by the chain.
+	buf, err := proof.Marshal()
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal proof: %w", err)
+	}
+
+	// Sign the proof bytes.
+	signature, _, err := c.Sign(name, buf)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign proof: %w", err)
+	}
+
+	return signature, nil
+}
+
+// UpdateSession submits the bandwidth and duration proof of a session on behalf of the node owned by the signing account.
+// Returns the broadcast result and any error encountered.
+func (c *Client) UpdateSession(ctx context.Context, proof *v3.Proof, signature []byte) (*core.ResultBroadcastTx, error) {
+	accAddr, err := c.FromAddr()
+	if err != nil {
+		return nil, err
+	}
+
+	// Build the message and broadcast it.
+	msg := NewMsgUpdateSessionRequest(accAddr.Bytes(), proof, signature)
+	return c.BroadcastMsgs(ctx, msg)
+}
+
+// CancelSession cancels the session with the given ID, which must be owned by the signing account.
+// Returns the broadcast result and any error encountered.
+func (c *Client) CancelSession(ctx context.Context, id uint64) (*core.ResultBroadcastTx, error) {
+	accAddr, err := c.FromAddr()
+	if err != nil {
+		return nil, err
+	}
+
+	// Build the message and broadcast it.
+	msg := v3.NewMsgCancelSessionRequest(accAddr, id)
+	return c.BroadcastMsgs(ctx, msg)
+}
@@ -3,8 +3,12 @@ package client
 import (
 	"context"
 
+	"cosmossdk.io/math"
+	core "github.com/cometbft/cometbft/rpc/core/types"
 	"github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
+	sentinelhub "github.com/sentinel-official/hub/v12/types"
+	"github.com/sentinel-official/hub/v12/types/v1"
 	"github.com/sentinel-official/hub/v12/x/subscription/types/v2"
 	"github.com/sentinel-official/hub/v12/x/subscription/types/v3"
 )
@@ -17,6 +21,7 @@ const (
 	methodQuerySubscriptionsForPlan    = "/sentinel.subscription.v3.QueryService/QuerySubscriptionsForPlan"    // Fetch subscriptions associated with a specific plan
 	methodQuerySubscriptionAllocation  = "/sentinel.subscription.v2.QueryService/QueryAllocation"              // Fetch details of a specific allocation within a subscription
 	methodQuerySubscriptionAllocations = "/sentinel.subscription.v2.QueryService/QueryAllocations"             // Fetch a list of allocations within a subscription
+	methodQuerySubscriptionParams      = "/sentinel.subscription.v2.QueryService/QueryParams"                  // Retrieve the parameters of the subscription module
 )
 
 // Subscription retrieves details of a specific subscription by its ID.
@@ -51,6 +56,14 @@ func (c *Client) Subscriptions(ctx context.Context, pageReq *query.PageRequest)
 	return resp.Subscriptions, resp.Pagination, nil
 }
 
+// AllSubscriptions returns an iterator over all subscriptions.
+// Pages are fetched on demand according to the given options.
+func (c *Client) AllSubscriptions(ctx context.Context, opts PageOptions) func(yield func(v3.Subscription, error) bool) {
+	return iteratePages(ctx, opts, func(ctx context.Context, pageReq *query.PageRequest) ([]v3.Subscription, *query.PageResponse, error) {
+		return c.Subscriptions(ctx, pageReq)
+	})
+}
+
 // SubscriptionsForAccount retrieves subscriptions associated with a specific account.
 // Returns the subscriptions, pagination details, and any error encountered.
 func (c *Client) SubscriptionsForAccount(ctx context.Context, accAddr types.AccAddress, pageReq *query.PageRequest) (res []v3.Subscription, pageRes *query.PageResponse, err error) {
@@ -70,6 +83,14 @@ func (c *Client) SubscriptionsForAccount(ctx context.Context, accAddr types.AccA
 	return resp.Subscriptions, resp.Pagination, nil
 }
 
+// AllSubscriptionsForAccount returns an iterator over all subscriptions associated with a specific account.
+// Pages are fetched on demand according to the given options.
+func (c *Client) AllSubscriptionsForAccount(ctx context.Context, accAddr types.AccAddress, opts PageOptions) func(yield func(v3.Subscription, error) bool) {
+	return iteratePages(ctx, opts, func(ctx context.Context, pageReq *query.PageRequest) ([]v3.Subscription, *query.PageResponse, error) {
+		return c.SubscriptionsForAccount(ctx, accAddr, pageReq)
+	})
+}
+
 // SubscriptionsForPlan retrieves subscriptions associated with a specific plan.
 // Returns the subscriptions, pagination details, and any error encountered.
 func (c *Client) SubscriptionsForPlan(ctx context.Context, id uint64, pageReq *query.PageRequest) (res []v3.Subscription, pageRes *query.PageResponse, err error) {
@@ -89,6 +110,14 @@ func (c *Client) SubscriptionsForPlan(ctx context.Context, id uint64, pageReq *q
 	return resp.Subscriptions, resp.Pagination, nil
 }
 
+// AllSubscriptionsForPlan returns an iterator over all subscriptions associated with a specific plan.
+// Pages are fetched on demand according to the given options.
+func (c *Client) AllSubscriptionsForPlan(ctx context.Context, id uint64, opts PageOptions) func(yield func(v3.Subscription, error) bool) {
+	return iteratePages(ctx, opts, func(ctx context.Context, pageReq *query.PageRequest) ([]v3.Subscription, *query.PageResponse, error) {
+		return c.SubscriptionsForPlan(ctx, id, pageReq)
+	})
+}
+
 // SubscriptionAllocation retrieves details of a specific allocation within a subscription.
 // Returns the allocation details and any error encountered.
 func (c *Client) SubscriptionAllocation(ctx context.Context, id uint64, accAddr types.AccAddress) (res *v2.Allocation, err error) {
@@ -126,3 +155,105 @@ func (c *Client) SubscriptionAllocations(ctx context.Context, id uint64, pageReq
 
 	return resp.Allocations, resp.Pagination, nil
 }
+
+// AllSubscriptionAllocations returns an iterator over all allocations within a specific subscription.
+// Pages are fetched on demand according to the given options.
+func (c *Client) AllSubscriptionAllocations(ctx context.Context, id uint64, opts PageOptions) func(yield func(v2.Allocation, error) bool) {
+	return iteratePages(ctx, opts, func(ctx context.Context, pageReq *query.PageRequest) ([]v2.Allocation, *query.PageResponse, error) {
+		return c.SubscriptionAllocations(ctx, id, pageReq)
+	})
+}
+
+// SubscriptionParams retrieves the parameters of the subscription module.
+// Returns the module parameters and any error encountered.
+func (c *Client) SubscriptionParams(ctx context.Context) (*v2.Params, error) {
+	var (
+		resp v2.QueryParamsResponse
+		req  = &v2.QueryParamsRequest{}
+	)
+
+	// Perform the gRPC query to fetch the module parameters.
+	if err := c.QueryGRPC(ctx, methodQuerySubscriptionParams, req, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp.Params, nil
+}
+
+// StartSubscription subscribes the signing account to the plan with the given ID, paying in the given denom.
+// Returns the broadcast result and any error encountered.
+func (c *Client) StartSubscription(ctx context.Context, id uint64, denom string, renewalPricePolicy v1.RenewalPricePolicy) (*core.ResultBroadcastTx, error) {
+	accAddr, err := c.FromAddr()
+	if err != nil {
+		return nil, err
+	}
+
+	// Build the message and broadcast it.
+	msg := v3.NewMsgStartSubscriptionRequest(accAddr, id, denom, renewalPricePolicy)
+	return c.BroadcastMsgs(ctx, msg)
+}
+
+// RenewSubscription renews the subscription with the given ID, paying in the given denom.
+// Returns the broadcast result and any error encountered.
+func (c *Client) RenewSubscription(ctx context.Context, id uint64, denom string) (*core.ResultBroadcastTx, error) {
+	accAddr, err := c.FromAddr()
+	if err != nil {
+		return nil, err
+	}
+
+	// Build the message and broadcast it.
+	msg := v3.NewMsgRenewSubscriptionRequest(accAddr, id, denom)
+	return c.BroadcastMsgs(ctx, msg)
+}
+
+// ShareSubscription allocates the given number of bytes of the subscription to the specified account.
+// Returns the broadcast result and any error encountered.
+func (c *Client) ShareSubscription(ctx context.Context, id uint64, accAddr types.AccAddress, bytes math.Int) (*core.ResultBroadcastTx, error) {
+	fromAddr, err := c.FromAddr()
+	if err != nil {
+		return nil, err
+	}
+
+	// Build the message and broadcast it.
+	msg := v3.NewMsgShareSubscriptionRequest(fromAddr, id, accAddr, bytes)
+	return c.BroadcastMsgs(ctx, msg)
+}
+
+// UpdateSubscription updates the renewal price policy of the subscription with the given ID.
+// Returns the broadcast result and any error encountered.
+func (c *Client) UpdateSubscription(ctx context.Context, id uint64, renewalPricePolicy v1.RenewalPricePolicy) (*core.ResultBroadcastTx, error) {
+	accAddr, err := c.FromAddr()
+	if err != nil {
+		return nil, err
+	}
+
+	// Build the message and broadcast it.
+	msg := v3.NewMsgUpdateSubscriptionRequest(accAddr, id, renewalPricePolicy)
+	return c.BroadcastMsgs(ctx, msg)
+}
+
+// CancelSubscription cancels the subscription with the given ID.
+// Returns the broadcast result and any error encountered.
+func (c *Client) CancelSubscription(ctx context.Context, id uint64) (*core.ResultBroadcastTx, error) {
+	accAddr, err := c.FromAddr()
+	if err != nil {
+		return nil, err
+	}
+
+	// Build the message and broadcast it.
+	msg := v3.NewMsgCancelSubscriptionRequest(accAddr, id)
+	return c.BroadcastMsgs(ctx, msg)
+}
+
+// StartSessionOnSubscription starts a session on the given node using the allocation of the signing account within the subscription.
+// Returns the broadcast result and any error encountered.
+func (c *Client) StartSessionOnSubscription(ctx context.Context, id uint64, nodeAddr sentinelhub.NodeAddress) (*core.ResultBroadcastTx, error) {
+	accAddr, err := c.FromAddr()
+	if err != nil {
+		return nil, err
+	}
+
+	// Build the message and broadcast it.
+	msg := v3.NewMsgStartSessionRequest(accAddr, id, nodeAddr)
+	return c.BroadcastMsgs(ctx, msg)
+}
@@ -2,9 +2,16 @@ package client
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/libs/bytes"
+	"github.com/cometbft/cometbft/mempool"
 	core "github.com/cometbft/cometbft/rpc/core/types"
+	rpctypes "github.com/cometbft/cometbft/rpc/jsonrpc/types"
+	cmttypes "github.com/cometbft/cometbft/types"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	cosmossdk "github.com/cosmos/cosmos-sdk/types"
@@ -12,6 +19,7 @@ import (
 	txsigning "github.com/cosmos/cosmos-sdk/types/tx/signing"
 	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
 	auth "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/gogoproto/proto"
 )
 
 const (
@@ -19,6 +27,137 @@ const (
 	methodSimulate = "/cosmos.tx.v1beta1.Service/Simulate"
 )
 
+const (
+	// Bounds of the interval between polls while waiting for a transaction to be included in a block
+	txWaitMinInterval = 500 * time.Millisecond
+	txWaitMaxInterval = 5 * time.Second
+)
+
+// TxError represents a transaction that was rejected during CheckTx or failed during DeliverTx.
+type TxError struct {
+	Hash      bytes.HexBytes // Hash of the transaction
+	Height    int64          // Height of the block including the transaction, zero if rejected during CheckTx
+	Codespace string         // Codespace of the ABCI error
+	Code      uint32         // Code of the ABCI error
+	Log       string         // Log describing the error
+}
+
+// Error returns the string representation of the transaction error.
+func (e *TxError) Error() string {
+	return fmt.Sprintf("tx %s failed with codespace %s and code %d: %s", e.Hash, e.Codespace, e.Code, e.Log)
+}
+
+// Is checks whether the transaction error is classified as the given sentinel error.
+func (e *TxError) Is(target error) bool {
+	err := classifyABCIError(e.Codespace, e.Code)
+	return err != nil && err == target
+}
+
+// checkTxError returns a TxError if the broadcast result indicates that the transaction was rejected during CheckTx.
+func checkTxError(res *core.ResultBroadcastTx) error {
+	if res.Code == abci.CodeTypeOK {
+		return nil
+	}
+
+	return &TxError{
+		Hash:      res.Hash,
+		Codespace: res.Codespace,
+		Code:      res.Code,
+		Log:       res.Log,
+	}
+}
+
+// TxResult contains the outcome of a transaction included in a block.
+type TxResult struct {
+	Hash      bytes.HexBytes // Hash of the transaction
+	Height    int64          // Height of the block including the transaction
+	GasWanted int64          // Gas limit requested by the transaction
+	GasUsed   int64          // Gas consumed by the transaction
+	Events    []abci.Event   // Events emitted during the execution of the transaction
+}
+
+// TypedEvents decodes the typed events emitted during the execution of the transaction.
+// Events that were not emitted as typed protobuf messages are skipped.
+func (r *TxResult) TypedEvents() ([]proto.Message, error) {
+	var items []proto.Message
+	for _, event := range r.Events {
+		// Skip events without a registered protobuf message type.
+		if proto.MessageType(event.Type) == nil {
+			continue
+		}
+
+		item, err := cosmossdk.ParseTypedEvent(event)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse typed event %s: %w", event.Type, err)
+		}
+
+		items = append(items, item)
+	}
+
+	return items, nil
+}
+
+// newTxResult returns the outcome of the given transaction included in a block, along with a TxError if the
+// transaction failed during DeliverTx.
+func newTxResult(res *core.ResultTx) (*TxResult, *TxError) {
+	result := &TxResult{
+		Hash:      res.Hash,
+		Height:    res.Height,
+		GasWanted: res.TxResult.GasWanted,
+		GasUsed:   res.TxResult.GasUsed,
+		Events:    res.TxResult.Events,
+	}
+
+	if !res.TxResult.IsErr() {
+		return result, nil
+	}
+
+	return result, &TxError{
+		Hash:      res.Hash,
+		Height:    res.Height,
+		Codespace: res.TxResult.Codespace,
+		Code:      res.TxResult.Code,
+		Log:       res.TxResult.Log,
+	}
+}
+
+// isTxNotFoundError checks if the given error is the RPC error returned by the tx route of CometBFT when the
+// transaction with the given hash is not indexed yet.
+func isTxNotFoundError(err error, hash []byte) bool {
+	var rpcErr *rpctypes.RPCError
+	if !errors.As(err, &rpcErr) {
+		return false
+	}
+
+	return rpcErr.Data == fmt.Sprintf("tx (%X) not found", hash)
+}
+
+// isMempoolFullError checks if the given error is the RPC error returned by CometBFT when its mempool cannot
+// accept more transactions, which carries a mempool.ErrMempoolIsFull as its data.
+func isMempoolFullError(err error) bool {
+	var rpcErr *rpctypes.RPCError
+	if !errors.As(err, &rpcErr) {
+		return false
+	}
+
+	var full mempool.ErrMempoolIsFull
+	_, scanErr := fmt.Sscanf(rpcErr.Data, "mempool is full: number of txs %d (max: %d), total txs bytes %d (max: %d)",
+		&full.NumTxs, &full.MaxTxs, &full.TxsBytes, &full.MaxTxsBytes)
+
+	return scanErr == nil && rpcErr.Data == full.Error()
+}
+
+// isTxInCacheError checks if the given error is the RPC error returned by CometBFT when the broadcast transaction
+// is already in the cache of its mempool.
+func isTxInCacheError(err error) bool {
+	var rpcErr *rpctypes.RPCError
+	if !errors.As(err, &rpcErr) {
+		return false
+	}
+
+	return rpcErr.Data == mempool.ErrTxInCache.Error()
+}
+
 // calculateFees computes transaction fees based on gas prices and gas limit.
 func calculateFees(gasPrices cosmossdk.DecCoins, gasLimit uint64) cosmossdk.Coins {
 	fees := make(cosmossdk.Coins, len(gasPrices))
@@ -38,8 +177,9 @@ func (c *Client) Simulate(ctx context.Context, buf []byte) (*tx.SimulateResponse
 		req  = &tx.SimulateRequest{TxBytes: buf}
 	)
 
-	// Perform a gRPC query to simulate the transaction.
-	if err := c.QueryGRPC(ctx, methodSimulate, req, &resp); err != nil {
+	// Perform a gRPC query to simulate the transaction through the configured querier.
+	querier := c.querierOrDefault(c.txSimulateQuerier, c.querier)
+	if err := c.queryGRPC(ctx, querier, methodSimulate, req, &resp); err != nil {
 		return nil, fmt.Errorf("failed to query simulate: %w", err)
 	}
 
@@ -65,33 +205,43 @@ func (c *Client) gasSimulateTx(ctx context.Context, txb client.TxBuilder) (uint6
 	return uint64(c.txGasAdjustment * float64(res.GasInfo.GasUsed)), nil
 }
 
-// broadcastTxSync broadcasts a transaction synchronously.
+// broadcastTxSync broadcasts an encoded transaction synchronously.
+// A transaction already in the mempool cache of the node, such as one resent after its broadcast timed out, is
+// reported as accepted, since the node received it before.
 // Returns the broadcast result or an error if the operation fails.
-func (c *Client) broadcastTxSync(ctx context.Context, txb client.TxBuilder) (*core.ResultBroadcastTx, error) {
-	// Encode the transaction into bytes.
-	buf, err := c.txConfig.TxEncoder()(txb.GetTx())
-	if err != nil {
-		return nil, fmt.Errorf("failed to encode tx: %w", err)
-	}
-
-	// Get the HTTP client for broadcasting.
-	http, err := c.HTTP()
+func (c *Client) broadcastTxSync(ctx context.Context, buf []byte) (*core.ResultBroadcastTx, error) {
+	// Get the HTTP client of the healthiest endpoint for broadcasting.
+	endpoint, http, err := c.rpc()
 	if err != nil {
 		return nil, fmt.Errorf("failed to create rpc client: %w", err)
 	}
 
 	// Broadcast the transaction synchronously.
+	start := time.Now()
 	res, err := http.BroadcastTxSync(ctx, buf)
 	if err != nil {
-		return nil, fmt.Errorf("failed to broadcast tx: %w", err)
+		if isTxInCacheError(err) {
+			endpoint.observe(ctx, start, 0, nil)
+			return &core.ResultBroadcastTx{Hash: cmttypes.Tx(buf).Hash()}, nil
+		}
+
+		endpoint.observe(ctx, start, 0, err)
+
+		// A full mempool is reported by the node as an RPC error rather than a CheckTx code.
+		if isMempoolFullError(err) {
+			return nil, fmt.Errorf("failed to broadcast tx: %w: %w", ErrMempoolFull, err)
+		}
+
+		return nil, fmt.Errorf("failed to broadcast tx: %w: %w", ErrUnavailable, err)
 	}
 
+	endpoint.observe(ctx, start, 0, nil)
 	return res, nil
 }
 
-// signTx signs a transaction using the provided key and account information.
+// signTx signs a transaction using the provided key and signer data.
 // Returns an error if the signing process fails.
-func (c *Client) signTx(txb client.TxBuilder, key *keyring.Record, account auth.AccountI) error {
+func (c *Client) signTx(txb client.TxBuilder, key *keyring.Record, signerData authsigning.SignerData) error {
 	// Prepare the single signature data.
 	singleSignatureData := txsigning.SingleSignatureData{
 		SignMode:  txsigning.SignMode_SIGN_MODE_DIRECT,
@@ -108,7 +258,7 @@ func (c *Client) signTx(txb client.TxBuilder, key *keyring.Record, account auth.
 	signature := txsigning.SignatureV2{
 		PubKey:   pubKey,
 		Data:     &singleSignatureData,
-		Sequence: account.GetSequence(),
+		Sequence: signerData.Sequence,
 	}
 
 	// Set the initial signature in the transaction builder.
@@ -116,13 +266,6 @@ func (c *Client) signTx(txb client.TxBuilder, key *keyring.Record, account auth.
 		return fmt.Errorf("failed to set initial signatures: %w", err)
 	}
 
-	// Prepare the signer data for signing the transaction.
-	signerData := authsigning.SignerData{
-		ChainID:       c.chainID,
-		AccountNumber: account.GetAccountNumber(),
-		Sequence:      account.GetSequence(),
-	}
-
 	// Get the bytes to be signed.
 	buf, err := c.txConfig.SignModeHandler().GetSignBytes(singleSignatureData.SignMode, signerData, txb.GetTx())
 	if err != nil {
@@ -130,7 +273,7 @@ func (c *Client) signTx(txb client.TxBuilder, key *keyring.Record, account auth.
 	}
 
 	// Sign the transaction bytes.
-	buf, _, err = c.Sign(c.txFromName, buf)
+	buf, _, err = c.Sign(key.Name, buf)
 	if err != nil {
 		return fmt.Errorf("failed to sign tx bytes`: %w", err)
 	}
@@ -147,9 +290,10 @@ func (c *Client) signTx(txb client.TxBuilder, key *keyring.Record, account auth.
 	return nil
 }
 
-// prepareTx prepares a transaction for broadcasting by setting fees, gas, and other parameters.
+// newTxBuilder creates a transaction builder with the given messages and the configured fees, gas, memo and
+// timeout height.
 // Returns the transaction builder and any error encountered.
-func (c *Client) prepareTx(ctx context.Context, key *keyring.Record, account auth.AccountI, msgs []cosmossdk.Msg) (client.TxBuilder, error) {
+func (c *Client) newTxBuilder(feeGranterAddr cosmossdk.AccAddress, msgs []cosmossdk.Msg) (client.TxBuilder, error) {
 	// Create a new transaction builder.
 	txb := c.txConfig.NewTxBuilder()
 	if err := txb.SetMsgs(msgs...); err != nil {
@@ -158,7 +302,7 @@ func (c *Client) prepareTx(ctx context.Context, key *keyring.Record, account aut
 
 	// Set transaction parameters.
 	txb.SetFeeAmount(c.txFees)
-	txb.SetFeeGranter(c.txFeeGranterAddr)
+	txb.SetFeeGranter(feeGranterAddr)
 	txb.SetGasLimit(c.txGas)
 	txb.SetMemo(c.txMemo)
 	txb.SetTimeoutHeight(c.txTimeoutHeight)
@@ -168,6 +312,18 @@ func (c *Client) prepareTx(ctx context.Context, key *keyring.Record, account aut
 		txb.SetFeeAmount(fees)
 	}
 
+	return txb, nil
+}
+
+// prepareTx prepares a transaction for broadcasting by setting fees, gas, the fee granter, and other parameters.
+// Returns the transaction builder and any error encountered.
+func (c *Client) prepareTx(ctx context.Context, key *keyring.Record, account auth.AccountI, msgs []cosmossdk.Msg) (client.TxBuilder, error) {
+	// Create a new transaction builder with the configured parameters.
+	txb, err := c.newTxBuilder(c.txFeeGranterAddr, msgs)
+	if err != nil {
+		return nil, err
+	}
+
 	// Retrieve the public key from the key record.
 	pubKey, err := key.GetPubKey()
 	if err != nil {
@@ -188,23 +344,122 @@ func (c *Client) prepareTx(ctx context.Context, key *keyring.Record, account aut
 	}
 
 	// Simulate the transaction to calculate gas usage if required.
+	gasLimit := c.txGas
 	if c.txSimulateAndExecute {
-		gasLimit, err := c.gasSimulateTx(ctx, txb)
+		// Select a fee granter covering the fee set so far, so that the simulation deducts it as the chain will.
+		if err := c.setFeeGranter(ctx, txb, account.GetAddress(), msgs); err != nil {
+			return nil, err
+		}
+
+		gasLimit, err = c.gasSimulateTx(ctx, txb)
 		if err != nil {
 			return nil, fmt.Errorf("failed to simulate tx for gas estimation: %w", err)
 		}
 
 		txb.SetGasLimit(gasLimit)
-		if !c.txGasPrices.IsZero() {
+		if c.txFeeEstimator == nil && !c.txGasPrices.IsZero() {
 			fees := calculateFees(c.txGasPrices, gasLimit)
 			txb.SetFeeAmount(fees)
 		}
 	}
 
+	// Estimate the fees from the minimum gas prices of the node if required.
+	if c.txFeeEstimator != nil {
+		fees, err := c.estimateTxFees(ctx, gasLimit)
+		if err != nil {
+			return nil, err
+		}
+
+		txb.SetFeeAmount(fees)
+	}
+
+	// Select the fee granter covering the final fee of the transaction.
+	if err := c.setFeeGranter(ctx, txb, account.GetAddress(), msgs); err != nil {
+		return nil, err
+	}
+
 	return txb, nil
 }
 
+// signSequencedTx prepares and signs a transaction using the cached sequence of the account, which is resynced from
+// the chain when it is not cached.
+// Returns the encoded transaction or an error.
+func (c *Client) signSequencedTx(ctx context.Context, key *keyring.Record, accAddr cosmossdk.AccAddress, seq *accountSequence, msgs []cosmossdk.Msg) ([]byte, error) {
+	// Retrieve the sender's account information if the sequence is not cached.
+	account := seq.get()
+	if account == nil {
+		var err error
+
+		account, err = c.Account(ctx, accAddr)
+		if err != nil {
+			return nil, fmt.Errorf("failed to query account: %w", err)
+		}
+		if account == nil {
+			return nil, fmt.Errorf("account %s does not exist", accAddr)
+		}
+
+		seq.set(account)
+	}
+
+	// Prepare the transaction for broadcasting.
+	txb, err := c.prepareTx(ctx, key, account, msgs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to prepare tx for broadcast: %w", err)
+	}
+
+	// Sign the transaction.
+	signerData := authsigning.SignerData{
+		ChainID:       c.chainID,
+		AccountNumber: account.GetAccountNumber(),
+		Sequence:      account.GetSequence(),
+	}
+
+	if err := c.signTx(txb, key, signerData); err != nil {
+		return nil, fmt.Errorf("failed to sign tx for broadcast: %w", err)
+	}
+
+	// Encode the transaction into bytes.
+	buf, err := c.txConfig.TxEncoder()(txb.GetTx())
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode tx: %w", err)
+	}
+
+	return buf, nil
+}
+
+// broadcastSequencedTx broadcasts a transaction signed with the cached sequence of the account, advancing the
+// sequence once the transaction enters the mempool.
+// Returns the broadcast result or an error.
+func (c *Client) broadcastSequencedTx(ctx context.Context, seq *accountSequence, buf []byte) (*core.ResultBroadcastTx, error) {
+	// Broadcast the signed transaction synchronously.
+	res, err := c.broadcastTxSync(ctx, buf)
+	if err != nil {
+		// The transaction may or may not have reached the mempool, so resync the sequence next time.
+		seq.reset()
+		return nil, fmt.Errorf("failed to sync broadcast tx: %w", err)
+	}
+
+	// Advance the sequence only if the transaction was accepted into the mempool.
+	if res.Code == abci.CodeTypeOK {
+		seq.increment()
+	}
+
+	return res, nil
+}
+
+// isTxIncluded checks whether the transaction with the given hash is included in a block.
+func (c *Client) isTxIncluded(ctx context.Context, hash []byte) bool {
+	_, err := c.Tx(ctx, hash)
+	return err == nil
+}
+
 // BroadcastTx broadcasts a signed transaction and returns the broadcast result or an error.
+// Transactions from the same key are signed and broadcast one at a time using a locally cached sequence,
+// which is resynced from the chain and the transaction retried once if the chain reports a sequence mismatch.
+// Broadcasts failing because the mempool is full or the request timed out are retried based on the retry policy.
+// A transaction whose broadcast timed out is resent as is, and reported as broadcast if the node already has it.
+// If the transaction is rejected during CheckTx, the broadcast result is returned along with a TxError, so callers
+// checking the code of the result should check for the error first.
 func (c *Client) BroadcastTx(ctx context.Context, msgs []cosmossdk.Msg) (*core.ResultBroadcastTx, error) {
 	// Retrieve the signing key.
 	key, err := c.Key(c.txFromName)
@@ -218,27 +473,122 @@ func (c *Client) BroadcastTx(ctx context.Context, msgs []cosmossdk.Msg) (*core.R
 		return nil, fmt.Errorf("failed to retrieve addr: %w", err)
 	}
 
-	// Retrieve the sender's account information.
-	account, err := c.Account(ctx, accAddr)
+	// Wrap the messages for execution on behalf of the granter, if configured.
+	msgs = c.wrapMsgsForExec(accAddr, msgs)
+
+	// Serialise the transactions signed by the account.
+	seq := c.accountSequence(accAddr)
+
+	seq.Lock()
+	defer seq.Unlock()
+
+	var (
+		res     *core.ResultBroadcastTx
+		pending []byte // Signed transaction whose broadcast timed out, which may have reached the mempool
+	)
+
+	// Define the function to sign and broadcast a new transaction, keeping it to be resent if the broadcast timed out.
+	signAndBroadcast := func() error {
+		buf, err := c.signSequencedTx(ctx, key, accAddr, seq, msgs)
+		if err != nil {
+			return err
+		}
+
+		res, err = c.broadcastSequencedTx(ctx, seq, buf)
+		if isTimeoutError(err) {
+			pending = buf
+		}
+
+		return err
+	}
+
+	// Define the function to broadcast the transaction, retrying once with a resynced sequence if it was stale.
+	broadcastFunc := func() (err error) {
+		if pending == nil {
+			err = signAndBroadcast()
+		} else {
+			// Resend the transaction of the attempt that timed out rather than signing a new one with the same
+			// sequence, which the node would reject if the first one reached the mempool.
+			buf := pending
+			pending = nil
+
+			res, err = c.broadcastSequencedTx(ctx, seq, buf)
+			if isTimeoutError(err) {
+				pending = buf
+			}
+
+			// A stale sequence may also mean that the transaction was already included in a block.
+			if err == nil && isSequenceMismatch(res) && c.isTxIncluded(ctx, res.Hash) {
+				res = &core.ResultBroadcastTx{Hash: res.Hash}
+			}
+		}
+		if err != nil {
+			return err
+		}
+
+		// Resync the sequence and retry once if the cached sequence was stale.
+		if isSequenceMismatch(res) {
+			seq.reset()
+
+			if err := signAndBroadcast(); err != nil {
+				return err
+			}
+		}
+
+		return checkTxError(res)
+	}
+
+	if err := c.retryBroadcast(ctx, broadcastFunc); err != nil {
+		var txErr *TxError
+		if errors.As(err, &txErr) {
+			return res, err
+		}
+
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// FromAddr retrieves the account address on whose behalf messages are built.
+// This is the granter address if messages are executed through authz, otherwise the address of the key
+// used for signing transactions.
+// Returns the account address or an error if the key cannot be found.
+func (c *Client) FromAddr() (cosmossdk.AccAddress, error) {
+	if !c.txExecGranterAddr.Empty() {
+		return c.txExecGranterAddr, nil
+	}
+
+	// Retrieve the signing key.
+	key, err := c.Key(c.txFromName)
 	if err != nil {
-		return nil, fmt.Errorf("failed to query account: %w", err)
+		return nil, fmt.Errorf("failed to retrieve key: %w", err)
 	}
 
-	// Prepare the transaction for broadcasting.
-	txb, err := c.prepareTx(ctx, key, account, msgs)
+	// Get the sender's address from the key.
+	accAddr, err := key.GetAddress()
 	if err != nil {
-		return nil, fmt.Errorf("failed to prepare tx for broadcast: %w", err)
+		return nil, fmt.Errorf("failed to retrieve addr: %w", err)
 	}
 
-	// Sign the transaction.
-	if err := c.signTx(txb, key, account); err != nil {
-		return nil, fmt.Errorf("failed to sign tx for broadcast: %w", err)
+	return accAddr, nil
+}
+
+// BroadcastMsgs validates the provided messages and broadcasts them within a single transaction.
+// Returns the broadcast result or an error if validation or broadcasting fails. If the transaction is rejected
+// during CheckTx, the broadcast result is returned along with a TxError.
+func (c *Client) BroadcastMsgs(ctx context.Context, msgs ...cosmossdk.Msg) (*core.ResultBroadcastTx, error) {
+	// Perform the stateless validation of each message.
+	for _, msg := range msgs {
+		if err := msg.ValidateBasic(); err != nil {
+			return nil, fmt.Errorf("invalid message %T: %w", msg, err)
+		}
 	}
 
-	// Broadcast the signed transaction synchronously.
-	res, err := c.broadcastTxSync(ctx, txb)
+	// Broadcast the validated messages.
+	res, err := c.BroadcastTx(ctx, msgs)
 	if err != nil {
-		return nil, fmt.Errorf("failed to sync broadcast tx: %w", err)
+		return res, fmt.Errorf("failed to broadcast msgs: %w", err)
 	}
 
 	return res, nil
@@ -247,17 +597,114 @@ func (c *Client) BroadcastTx(ctx context.Context, msgs []cosmossdk.Msg) (*core.R
 // Tx retrieves a transaction from the blockchain using its hash.
 // Returns the transaction result or an error.
 func (c *Client) Tx(ctx context.Context, hash []byte) (*core.ResultTx, error) {
-	// Get the HTTP client for querying the blockchain.
-	http, err := c.HTTP()
+	// Get the HTTP client of the healthiest endpoint for querying the blockchain.
+	endpoint, http, err := c.rpc()
 	if err != nil {
 		return nil, fmt.Errorf("failed to create rpc client: %w", err)
 	}
 
 	// Perform the query using the transaction hash.
+	start := time.Now()
 	res, err := http.Tx(ctx, hash, c.queryProve)
 	if err != nil {
-		return nil, fmt.Errorf("failed to query tx: %w", err)
+		// A transaction that is not indexed yet does not indicate an unhealthy endpoint.
+		if isTxNotFoundError(err, hash) {
+			return nil, fmt.Errorf("failed to query tx: %w: %w", ErrNotFound, err)
+		}
+
+		endpoint.observe(ctx, start, 0, err)
+		return nil, fmt.Errorf("failed to query tx: %w: %w", ErrUnavailable, err)
 	}
 
+	endpoint.observe(ctx, start, 0, nil)
 	return res, nil
 }
+
+// WaitForTx waits until the transaction with the given hash is included in a block.
+// Polls the RPC server with an exponential backoff until the transaction is found, the context is done,
+// or the latest block height exceeds the configured transaction timeout height.
+// Returns the transaction result, along with a TxError if the transaction failed during DeliverTx.
+func (c *Client) WaitForTx(ctx context.Context, hash []byte) (*TxResult, error) {
+	interval := txWaitMinInterval
+	for {
+		// Query the transaction and return its result once it is found.
+		res, err := c.Tx(ctx, hash)
+		if err == nil {
+			result, txErr := newTxResult(res)
+			if txErr != nil {
+				return result, txErr
+			}
+
+			return result, nil
+		}
+		if !errors.Is(err, ErrNotFound) {
+			return nil, err
+		}
+
+		// Stop waiting once the transaction can no longer be included.
+		if c.txTimeoutHeight > 0 {
+			height, err := c.LatestHeight(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to query latest height: %w", err)
+			}
+			if height > int64(c.txTimeoutHeight) {
+				return nil, fmt.Errorf("tx %X not included before timeout height %d", hash, c.txTimeoutHeight)
+			}
+		}
+
+		// Wait before polling again, doubling the interval up to the maximum.
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("failed to wait for tx %X: %w", hash, ctx.Err())
+		case <-time.After(interval):
+		}
+
+		interval = min(2*interval, txWaitMaxInterval)
+	}
+}
+
+// BroadcastTxAndWait broadcasts a signed transaction and waits until it is included in a block.
+// The wait is bounded by the context deadline and the configured transaction timeout height.
+// Returns the transaction result, or a TxError if the transaction failed during CheckTx. If the transaction failed
+// during DeliverTx, the transaction result is returned along with a TxError.
+func (c *Client) BroadcastTxAndWait(ctx context.Context, msgs []cosmossdk.Msg) (*TxResult, error) {
+	// Broadcast the transaction synchronously, failing with a TxError if it was rejected during CheckTx.
+	res, err := c.BroadcastTx(ctx, msgs)
+	if err != nil {
+		return nil, err
+	}
+
+	// Wait for the transaction to be included in a block.
+	result, err := c.WaitForTx(ctx, res.Hash)
+	if err != nil {
+		var txErr *TxError
+		if errors.As(err, &txErr) {
+			return result, err
+		}
+
+		return nil, fmt.Errorf("failed to wait for tx: %w", err)
+	}
+
+	return result, nil
+}
+
+// LatestHeight retrieves the height of the latest block known to the RPC server.
+// Returns the block height or an error.
+func (c *Client) LatestHeight(ctx context.Context) (int64, error) {
+	// Get the HTTP client of the healthiest endpoint for querying the blockchain.
+	endpoint, http, err := c.rpc()
+	if err != nil {
+		return 0, fmt.Errorf("failed to create rpc client: %w", err)
+	}
+
+	// Query the status of the node.
+	start := time.Now()
+	res, err := http.Status(ctx)
+	if err != nil {
+		endpoint.observe(ctx, start, 0, err)
+		return 0, fmt.Errorf("failed to query status: %w: %w", ErrUnavailable, err)
+	}
+
+	endpoint.observe(ctx, start, res.SyncInfo.LatestBlockHeight, nil)
+	return res.SyncInfo.LatestBlockHeight, nil
+}
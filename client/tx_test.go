@@ -0,0 +1,215 @@
+package client_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/cometbft/cometbft/mempool"
+	cosmossdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/sentinel-official/sentinel-go-sdk/client"
+)
+
+// newTestMsgs returns a message sending a single coin from the given address to another account.
+func newTestMsgs(fromAddr cosmossdk.AccAddress) []cosmossdk.Msg {
+	toAddr := cosmossdk.AccAddress(newTestNodeAddr(9))
+	return []cosmossdk.Msg{
+		banktypes.NewMsgSend(fromAddr, toAddr, cosmossdk.NewCoins(cosmossdk.NewInt64Coin("udvpn", 1))),
+	}
+}
+
+func TestBroadcastTxAndWait(t *testing.T) {
+	ch := newTestChain(t)
+	c, accAddr := newTestClient(t, ch, "alice")
+	ctx := context.Background()
+
+	result, err := c.BroadcastTxAndWait(ctx, newTestMsgs(accAddr))
+	if err != nil {
+		t.Fatalf("BroadcastTxAndWait: %v", err)
+	}
+	if result.Height != ch.Height() || result.GasUsed == 0 {
+		t.Fatalf("BroadcastTxAndWait = %+v, want the result of the tx included at height %d", result, ch.Height())
+	}
+	if n := len(ch.BroadcastMsgs()); n != 1 {
+		t.Fatalf("chain received %d msgs, want 1", n)
+	}
+}
+
+func TestBroadcastTxAndWaitReturnsTxErrors(t *testing.T) {
+	ch := newTestChain(t)
+	c, accAddr := newTestClient(t, ch, "alice")
+	ctx := context.Background()
+
+	// A transaction rejected during CheckTx is never included, so there is no result to return.
+	ch.FailNextTx(sdkerrors.ErrInsufficientFunds, "spendable balance is smaller")
+	result, err := c.BroadcastTxAndWait(ctx, newTestMsgs(accAddr))
+
+	var txErr *client.TxError
+	if !errors.As(err, &txErr) || txErr.Code != sdkerrors.ErrInsufficientFunds.ABCICode() || txErr.Height != 0 {
+		t.Fatalf("BroadcastTxAndWait error = %v, want a CheckTx error", err)
+	}
+	if result != nil {
+		t.Fatalf("BroadcastTxAndWait = %+v, want no result for a CheckTx error", result)
+	}
+
+	// A transaction failing during DeliverTx is returned along with the result of its inclusion.
+	ch.FailNextDeliverTx(sdkerrors.ErrInsufficientFunds, "spendable balance is smaller")
+	result, err = c.BroadcastTxAndWait(ctx, newTestMsgs(accAddr))
+
+	if !errors.As(err, &txErr) || txErr.Code != sdkerrors.ErrInsufficientFunds.ABCICode() || txErr.Height != ch.Height() {
+		t.Fatalf("BroadcastTxAndWait error = %v, want a DeliverTx error at height %d", err, ch.Height())
+	}
+	if result == nil || result.Height != ch.Height() || result.GasUsed == 0 {
+		t.Fatalf("BroadcastTxAndWait = %+v, want the result of the failed tx", result)
+	}
+}
+
+func TestWaitForTxNotIncluded(t *testing.T) {
+	ch := newTestChain(t)
+	c, _ := newTestClient(t, ch, "alice")
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	// A transaction which is not indexed is polled for until the context is done.
+	_, err := c.WaitForTx(ctx, make([]byte, 32))
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("WaitForTx error = %v, want the deadline to be exceeded", err)
+	}
+}
+
+func TestBroadcastTxRetriesSequenceMismatch(t *testing.T) {
+	tests := []struct {
+		name  string
+		cache *client.QueryCache
+	}{
+		{name: "without query cache"},
+		{
+			// Account queries are never cached, even if opted in, so the resync reads the current sequence.
+			name:  "with query cache",
+			cache: client.NewQueryCache(100, time.Hour).WithMethods("/cosmos.auth.v1beta1.Query/Account"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ch := newTestChain(t)
+			c, accAddr := newTestClient(t, ch, "alice")
+			c.WithQueryCache(tt.cache)
+
+			ctx := context.Background()
+			if _, err := c.BroadcastTx(ctx, newTestMsgs(accAddr)); err != nil {
+				t.Fatalf("BroadcastTx: %v", err)
+			}
+
+			// Advance the sequence behind the back of the client, as a transaction signed elsewhere would.
+			account := ch.Account(accAddr)
+			if err := account.SetSequence(account.GetSequence() + 1); err != nil {
+				t.Fatalf("failed to set sequence: %v", err)
+			}
+
+			ch.SetAccount(account)
+
+			res, err := c.BroadcastTx(ctx, newTestMsgs(accAddr))
+			if err != nil {
+				t.Fatalf("BroadcastTx after sequence change: %v", err)
+			}
+			if res.Code != 0 {
+				t.Fatalf("BroadcastTx code = %d, want 0", res.Code)
+			}
+			if n := len(ch.BroadcastMsgs()); n != 2 {
+				t.Fatalf("chain received %d msgs, want 2", n)
+			}
+			if seq := ch.Account(accAddr).GetSequence(); seq != 3 {
+				t.Fatalf("account sequence = %d, want 3", seq)
+			}
+		})
+	}
+}
+
+func TestBroadcastTxEstimatesFees(t *testing.T) {
+	ch := newTestChain(t)
+	ch.SetMinGasPrices(cosmossdk.NewDecCoins(cosmossdk.NewDecCoinFromDec("udvpn", cosmossdk.NewDecWithPrec(5, 1))))
+
+	c, accAddr := newTestClient(t, ch, "alice")
+	ctx := context.Background()
+
+	// Without fees, the transaction is rejected by the minimum gas prices of the node.
+	_, err := c.BroadcastTx(ctx, newTestMsgs(accAddr))
+
+	var txErr *client.TxError
+	if !errors.As(err, &txErr) || txErr.Code != sdkerrors.ErrInsufficientFee.ABCICode() {
+		t.Fatalf("BroadcastTx error = %v, want an insufficient fee error", err)
+	}
+
+	// Fees estimated from the minimum gas prices for the simulated gas are accepted.
+	c.WithTxGas(0).
+		WithTxGasAdjustment(1.5).
+		WithTxSimulateAndExecute(true).
+		WithTxFeeEstimator(client.NewFeeEstimator().WithPriority(client.FeePriorityLow))
+
+	if _, err := c.BroadcastTx(ctx, newTestMsgs(accAddr)); err != nil {
+		t.Fatalf("BroadcastTx with estimated fees: %v", err)
+	}
+}
+
+func TestBroadcastTxRetriesFullMempool(t *testing.T) {
+	ch := newTestChain(t)
+	c, accAddr := newTestClient(t, ch, "alice")
+	ctx := context.Background()
+
+	full := mempool.ErrMempoolIsFull{NumTxs: 5000, MaxTxs: 5000, TxsBytes: 1024, MaxTxsBytes: 1 << 30}
+
+	// Without a retry policy, the full mempool is reported to the caller.
+	ch.FailNextBroadcast(full)
+	if _, err := c.BroadcastTx(ctx, newTestMsgs(accAddr)); !errors.Is(err, client.ErrMempoolFull) {
+		t.Fatalf("BroadcastTx error = %v, want ErrMempoolFull", err)
+	}
+
+	// With a retry policy, the broadcast is retried once the mempool has room again.
+	c.WithRetryPolicy(client.NewRetryPolicy().WithBackoff(time.Millisecond, time.Millisecond))
+
+	ch.FailNextBroadcast(full)
+	if _, err := c.BroadcastTx(ctx, newTestMsgs(accAddr)); err != nil {
+		t.Fatalf("BroadcastTx: %v", err)
+	}
+	if n := len(ch.BroadcastMsgs()); n != 1 {
+		t.Fatalf("chain received %d msgs, want 1", n)
+	}
+}
+
+func TestBroadcastTxResendsTimedOutTx(t *testing.T) {
+	ch := newTestChain(t)
+	c, accAddr := newTestClient(t, ch, "alice")
+	ctx := context.Background()
+
+	c.WithRPCTimeout(time.Second).
+		WithRetryPolicy(client.NewRetryPolicy().WithBackoff(time.Millisecond, time.Millisecond))
+
+	// The node accepts the transaction but responds after the request timed out, so the retry resends a
+	// transaction it already has.
+	ch.DelayNextBroadcast(2 * time.Second)
+
+	res, err := c.BroadcastTx(ctx, newTestMsgs(accAddr))
+	if err != nil {
+		t.Fatalf("BroadcastTx: %v", err)
+	}
+	if n := len(ch.BroadcastMsgs()); n != 1 {
+		t.Fatalf("chain received %d msgs, want 1", n)
+	}
+	if _, err := c.Tx(ctx, res.Hash); err != nil {
+		t.Fatalf("Tx(%s): %v", res.Hash, err)
+	}
+
+	// The next transaction is signed with the following sequence.
+	if _, err := c.BroadcastTx(ctx, newTestMsgs(accAddr)); err != nil {
+		t.Fatalf("BroadcastTx: %v", err)
+	}
+	if n := len(ch.BroadcastMsgs()); n != 2 {
+		t.Fatalf("chain received %d msgs, want 2", n)
+	}
+}
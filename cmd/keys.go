@@ -2,10 +2,13 @@ package cmd
 
 import (
 	"bufio"
+	"encoding/base64"
 	"errors"
 	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/go-bip39"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -70,6 +73,8 @@ func keysAddCmd(c *client.Client) *cobra.Command {
 			account := viper.GetUint32("key.account")
 			coinType := viper.GetUint32("key.coin-type")
 			index := viper.GetUint32("key.index")
+			multisigNames := viper.GetStringSlice("multisig")
+			multisigThreshold := viper.GetInt("multisig-threshold")
 			outputFormat := viper.GetString("output-format")
 
 			// Check if the key already exists
@@ -77,6 +82,11 @@ func keysAddCmd(c *client.Client) *cobra.Command {
 				return fmt.Errorf("key with name '%s' already exists", args[0])
 			}
 
+			// Create a multisig key from the public keys of its members
+			if len(multisigNames) > 0 {
+				return keysAddMultisig(cmd, c, args[0], multisigNames, multisigThreshold, outputFormat)
+			}
+
 			reader := bufio.NewReader(cmd.InOrStdin())
 
 			// Prompt for mnemonic
@@ -136,11 +146,63 @@ func keysAddCmd(c *client.Client) *cobra.Command {
 	cmd.Flags().Uint32("key.account", 0, "account number to use for key creation")
 	cmd.Flags().Uint32("key.coin-type", 0, "coin type to use for key creation")
 	cmd.Flags().Uint32("key.index", 0, "index to use for key creation")
+	cmd.Flags().StringSlice("multisig", nil, "names of existing keys or base64 encoded secp256k1 public keys to create a multisig key from")
+	cmd.Flags().Int("multisig-threshold", 1, "number of signatures required by the multisig key")
 	cmd.Flags().String("output-format", "text", "format for command output (json or text)")
 
 	return cmd
 }
 
+// multisigMemberPubKey returns the public key of a multisig member, given either as the name of a key in the keyring
+// or as a base64 encoded secp256k1 public key, such as the key of a member whose private key is held elsewhere.
+func multisigMemberPubKey(c *client.Client, member string) (cryptotypes.PubKey, error) {
+	key, err := c.Key(member)
+	if err == nil {
+		return key.GetPubKey()
+	}
+
+	// Fall back to decoding the member as a public key
+	buf, decodeErr := base64.StdEncoding.DecodeString(member)
+	if decodeErr != nil || len(buf) != secp256k1.PubKeySize {
+		return nil, fmt.Errorf("member '%s' is neither a key in the keyring nor a base64 encoded public key: %w", member, err)
+	}
+
+	return &secp256k1.PubKey{Key: buf}, nil
+}
+
+// keysAddMultisig creates a multisig key with the specified name from the public keys of the given members.
+func keysAddMultisig(cmd *cobra.Command, c *client.Client, name string, members []string, threshold int, outputFormat string) error {
+	// Collect the public keys of the multisig members
+	pubKeys := make([]cryptotypes.PubKey, len(members))
+	for i, member := range members {
+		pubKey, err := multisigMemberPubKey(c, member)
+		if err != nil {
+			return err
+		}
+
+		pubKeys[i] = pubKey
+	}
+
+	// Create the multisig key
+	key, err := c.CreateMultisigKey(name, pubKeys, threshold)
+	if err != nil {
+		return err
+	}
+
+	output, err := keyring.MkAccKeyOutput(key)
+	if err != nil {
+		return err
+	}
+
+	// Output the key information
+	if err := writeOutputToCmd(cmd, output, outputFormat); err != nil {
+		return err
+	}
+
+	cmd.Println("Multisig key created successfully")
+	return nil
+}
+
 // keysDeleteCmd removes the key with the specified name.
 func keysDeleteCmd(c *client.Client) *cobra.Command {
 	cmd := &cobra.Command{
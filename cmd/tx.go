@@ -0,0 +1,293 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	cosmossdk "github.com/cosmos/cosmos-sdk/types"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/sentinel-official/sentinel-go-sdk/client"
+	"github.com/sentinel-official/sentinel-go-sdk/types"
+)
+
+// TxCmd returns a new Cobra command for the offline transaction sub-commands.
+func TxCmd() *cobra.Command {
+	c := client.New()
+	rootCmd := &cobra.Command{
+		Use:          "tx",
+		Short:        "Sub-commands for generating, signing and broadcasting transactions",
+		SilenceUsage: true,
+		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+			// Retrieve keyring configuration from environment variables or flags
+			homeDir := viper.GetString("home")
+			appName := viper.GetString("keyring.name")
+			backend := viper.GetString("keyring.backend")
+
+			// Initialize the protocol codec and the transaction configuration
+			protoCodec := types.NewProtoCodec()
+			txConfig := authtx.NewTxConfig(protoCodec, authtx.DefaultSignModes)
+
+			// Create a new keyring instance
+			kr, err := keyring.New(appName, backend, homeDir, cmd.InOrStdin(), protoCodec)
+			if err != nil {
+				return err
+			}
+
+			// Create a new client with the keyring and codecs
+			c.WithKeyring(kr)
+			c.WithProtoCodec(protoCodec)
+			c.WithTxConfig(txConfig)
+
+			return nil
+		},
+	}
+
+	// Add sub-commands for the offline transaction workflow
+	rootCmd.AddCommand(
+		txGenerateCmd(c),
+		txSignCmd(c),
+		txMultisignCmd(c),
+		txBroadcastCmd(c),
+	)
+
+	// Add persistent flags
+	rootCmd.PersistentFlags().String("keyring.backend", "os", "backend type for the keyring (e.g., 'os', 'file', or 'test')")
+	rootCmd.PersistentFlags().String("keyring.name", "sentinel", "name identifier for the keyring")
+
+	return rootCmd
+}
+
+// readMsgsFromFile reads the messages of a transaction body from the given JSON file, decoding them with the codec
+// of the client.
+func readMsgsFromFile(c *client.Client, path string) ([]cosmossdk.Msg, error) {
+	buf, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.DecodeMsgsJSON(buf)
+}
+
+// writeDocumentToCmd writes the given document to the file at path, or to the command's output if path is empty.
+func writeDocumentToCmd(cmd *cobra.Command, path string, buf []byte) error {
+	if path == "" {
+		cmd.Println(string(buf))
+		return nil
+	}
+
+	return os.WriteFile(path, buf, 0o644)
+}
+
+// txGenerateCmd generates an unsigned transaction from a file of messages.
+func txGenerateCmd(c *client.Client) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "generate [msgs-file]",
+		Short: "Generate an unsigned transaction from a JSON file containing a tx body with messages",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			accountNumber := viper.GetUint64("tx.account-number")
+			chainID := viper.GetString("tx.chain-id")
+			feeGranterAddr := viper.GetString("tx.fee-granter-addr")
+			fees := viper.GetString("tx.fees")
+			gas := viper.GetUint64("tx.gas")
+			gasPrices := viper.GetString("tx.gas-prices")
+			memo := viper.GetString("tx.memo")
+			outputDocument := viper.GetString("output-document")
+			sequence := viper.GetUint64("tx.sequence")
+			timeoutHeight := viper.GetUint64("tx.timeout-height")
+
+			// Parse the fee parameters
+			txFees, err := cosmossdk.ParseCoinsNormalized(fees)
+			if err != nil {
+				return fmt.Errorf("invalid fees: %w", err)
+			}
+
+			txGasPrices, err := cosmossdk.ParseDecCoins(gasPrices)
+			if err != nil {
+				return fmt.Errorf("invalid gas prices: %w", err)
+			}
+
+			var txFeeGranterAddr cosmossdk.AccAddress
+			if feeGranterAddr != "" {
+				txFeeGranterAddr, err = cosmossdk.AccAddressFromBech32(feeGranterAddr)
+				if err != nil {
+					return fmt.Errorf("invalid fee granter addr: %w", err)
+				}
+			}
+
+			c.WithChainID(chainID).
+				WithTxFeeGranterAddr(txFeeGranterAddr).
+				WithTxFees(txFees).
+				WithTxGas(gas).
+				WithTxGasPrices(txGasPrices).
+				WithTxMemo(memo).
+				WithTxTimeoutHeight(timeoutHeight)
+
+			// Read the messages and generate the unsigned transaction
+			msgs, err := readMsgsFromFile(c, args[0])
+			if err != nil {
+				return err
+			}
+
+			unsignedTx, err := c.GenerateTx(msgs, accountNumber, sequence)
+			if err != nil {
+				return err
+			}
+
+			buf, err := json.MarshalIndent(unsignedTx, "", "  ")
+			if err != nil {
+				return err
+			}
+
+			return writeDocumentToCmd(cmd, outputDocument, buf)
+		},
+	}
+
+	cmd.Flags().Uint64("tx.account-number", 0, "account number of the signer")
+	cmd.Flags().String("tx.chain-id", "", "chain ID the transaction is signed for")
+	cmd.Flags().String("tx.fee-granter-addr", "", "address of the account paying the fees")
+	cmd.Flags().String("tx.fees", "", "fees to pay along with the transaction (e.g., 10udvpn)")
+	cmd.Flags().Uint64("tx.gas", 200000, "gas limit of the transaction")
+	cmd.Flags().String("tx.gas-prices", "", "gas prices used to calculate the fees (e.g., 0.1udvpn)")
+	cmd.Flags().String("tx.memo", "", "memo attached to the transaction")
+	cmd.Flags().Uint64("tx.sequence", 0, "sequence of the signer")
+	cmd.Flags().Uint64("tx.timeout-height", 0, "block height after which the transaction is no longer valid")
+	cmd.Flags().String("output-document", "", "file to write the unsigned transaction to, instead of the output")
+
+	return cmd
+}
+
+// txSignCmd signs an unsigned transaction file with a key from the keyring.
+func txSignCmd(c *client.Client) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sign [unsigned-tx-file]",
+		Short: "Sign an unsigned transaction offline with the specified key",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			fromName := viper.GetString("tx.from-name")
+			multisig := viper.GetBool("tx.multisig")
+			outputDocument := viper.GetString("output-document")
+
+			// Read the unsigned transaction
+			buf, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			var unsignedTx client.UnsignedTx
+			if err := json.Unmarshal(buf, &unsignedTx); err != nil {
+				return fmt.Errorf("failed to unmarshal unsigned tx: %w", err)
+			}
+
+			// Sign the transaction, or produce a partial signature for a multisig account
+			if multisig {
+				buf, err = c.SignMultisigTx(fromName, &unsignedTx)
+			} else {
+				buf, err = c.SignTx(fromName, &unsignedTx)
+			}
+			if err != nil {
+				return err
+			}
+
+			return writeDocumentToCmd(cmd, outputDocument, buf)
+		},
+	}
+
+	cmd.Flags().String("tx.from-name", "", "name of the key used for signing the transaction")
+	cmd.Flags().Bool("tx.multisig", false, "produce a partial signature for a multisig account instead of a signed transaction")
+	cmd.Flags().String("output-document", "", "file to write the signed transaction to, instead of the output")
+
+	return cmd
+}
+
+// txMultisignCmd combines the partial signatures of a multisig account into a signed transaction file.
+func txMultisignCmd(c *client.Client) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "multisign [unsigned-tx-file] [multisig-name] [signature-file]...",
+		Short: "Combine the partial signatures of a multisig account into a signed transaction",
+		Args:  cobra.MinimumNArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			outputDocument := viper.GetString("output-document")
+
+			// Read the unsigned transaction
+			buf, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			var unsignedTx client.UnsignedTx
+			if err := json.Unmarshal(buf, &unsignedTx); err != nil {
+				return fmt.Errorf("failed to unmarshal unsigned tx: %w", err)
+			}
+
+			// Read the partial signatures
+			partialSigs := make([][]byte, len(args[2:]))
+			for i, path := range args[2:] {
+				partialSigs[i], err = os.ReadFile(path)
+				if err != nil {
+					return err
+				}
+			}
+
+			// Combine the partial signatures
+			buf, err = c.CombineMultisigTx(args[1], &unsignedTx, partialSigs)
+			if err != nil {
+				return err
+			}
+
+			return writeDocumentToCmd(cmd, outputDocument, buf)
+		},
+	}
+
+	cmd.Flags().String("output-document", "", "file to write the signed transaction to, instead of the output")
+
+	return cmd
+}
+
+// txBroadcastCmd broadcasts a signed transaction file.
+func txBroadcastCmd(c *client.Client) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "broadcast [signed-tx-file]",
+		Short: "Broadcast a signed transaction to the RPC servers",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			outputFormat := viper.GetString("output-format")
+			rpcAddrs := viper.GetStringSlice("rpc.addrs")
+			rpcTimeout := viper.GetDuration("rpc.timeout")
+
+			c.WithRPCAddrs(rpcAddrs...).
+				WithRPCTimeout(rpcTimeout)
+
+			// Read the signed transaction
+			buf, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			// Broadcast the transaction
+			res, err := c.BroadcastSignedTx(cmd.Context(), buf)
+			if res == nil {
+				return err
+			}
+
+			// Output the broadcast result, even if the transaction was rejected
+			if err := writeOutputToCmd(cmd, res, outputFormat); err != nil {
+				return err
+			}
+
+			return err
+		},
+	}
+
+	cmd.Flags().StringSlice("rpc.addrs", nil, "addresses of the RPC servers")
+	cmd.Flags().Duration("rpc.timeout", 15*time.Second, "timeout of the requests to the RPC servers")
+	cmd.Flags().String("output-format", "text", "format for command output (json or text)")
+
+	return cmd
+}